@@ -0,0 +1,101 @@
+// update_constants descarga las constantes de OpenDota (heroes, game_mode, lobby_type, items)
+// a {dir}/{nombre}.json. Por defecto escribe en dota/ (se embeben en el binario al recompilar);
+// con otro directorio sirve como CONSTANTS_DIR para actualizar sin recompilar.
+// Ejecutar desde la raíz del repo: go run ./cmd/update_constants [dir]
+package main
+
+import (
+	"bytes"
+	"dota-discord-bot/dota"
+	"encoding/json"
+	"fmt"
+	"io"
+	"net/http"
+	"os"
+	"path/filepath"
+	"time"
+)
+
+const openDotaConstantsURL = "https://api.opendota.com/api/constants"
+
+func main() {
+	outDir := "dota"
+	if len(os.Args) > 1 {
+		outDir = os.Args[1]
+	}
+	if err := os.MkdirAll(outDir, 0755); err != nil {
+		fmt.Fprintf(os.Stderr, "Error creando %s: %v\n", outDir, err)
+		os.Exit(1)
+	}
+
+	client := &http.Client{Timeout: 30 * time.Second}
+	for _, name := range dota.ConstantNames {
+		url := fmt.Sprintf("%s/%s", openDotaConstantsURL, name)
+		data, err := fetchConstant(client, url)
+		if err != nil {
+			fmt.Fprintf(os.Stderr, "Error descargando %s: %v\n", name, err)
+			os.Exit(1)
+		}
+		if name == "items" {
+			neutrals, err := dota.CheckItems(data)
+			if err != nil {
+				fmt.Fprintf(os.Stderr, "items no válido: %v\n", err)
+				os.Exit(1)
+			}
+			fmt.Printf("  items: %d neutrales\n", neutrals)
+		}
+		path := filepath.Join(outDir, name+".json")
+		if err := writeFile(path, data); err != nil {
+			fmt.Fprintf(os.Stderr, "Error escribiendo %s: %v\n", path, err)
+			os.Exit(1)
+		}
+		fmt.Printf("  %s -> %s (%d bytes)\n", name, path, len(data))
+		time.Sleep(1 * time.Second) // rate limit de OpenDota
+	}
+
+	// Verificar que el bot puede cargar lo descargado
+	if _, err := dota.LoadConstants(outDir); err != nil {
+		fmt.Fprintf(os.Stderr, "Las constantes descargadas no son válidas: %v\n", err)
+		os.Exit(1)
+	}
+	fmt.Printf("Listo: constantes actualizadas en %s\n", outDir)
+}
+
+// fetchConstant descarga un recurso de constantes y lo devuelve con indentación de 2 espacios.
+// Falla si la respuesta no es un objeto JSON con al menos una entrada.
+func fetchConstant(client *http.Client, url string) ([]byte, error) {
+	resp, err := client.Get(url)
+	if err != nil {
+		return nil, err
+	}
+	defer resp.Body.Close()
+	if resp.StatusCode != http.StatusOK {
+		return nil, fmt.Errorf("HTTP %d", resp.StatusCode)
+	}
+	body, err := io.ReadAll(resp.Body)
+	if err != nil {
+		return nil, err
+	}
+	var entries map[string]json.RawMessage
+	if err := json.Unmarshal(body, &entries); err != nil {
+		return nil, fmt.Errorf("JSON inválido: %w", err)
+	}
+	if len(entries) == 0 {
+		return nil, fmt.Errorf("respuesta vacía")
+	}
+	var out bytes.Buffer
+	if err := json.Indent(&out, body, "", "  "); err != nil {
+		return nil, err
+	}
+	out.WriteByte('\n')
+	return out.Bytes(), nil
+}
+
+// writeFile escribe en un temporal y renombra, para no dejar un JSON a medias si falla.
+func writeFile(path string, data []byte) error {
+	tmp := path + ".tmp"
+	if err := os.WriteFile(tmp, data, 0644); err != nil {
+		return err
+	}
+	return os.Rename(tmp, path)
+}
@@ -4,10 +4,26 @@ import (
 	"fmt"
 	"os"
 	"strconv"
+	"strings"
+	"time"
 
 	"github.com/joho/godotenv"
 )
 
+// Límites de las variables que Load valida y que /dota config set puede cambiar en caliente
+const (
+	MinRefreshRate   = 1
+	MaxRefreshRate   = 60
+	MinStatsMinGames = 2
+	MaxStatsTake     = 100
+)
+
+// RuntimeKeys son las variables de .env que se pueden cambiar sin reiniciar (/dota config set)
+var RuntimeKeys = []string{"REFRESH_RATE", "PARSED", "STATS_MIN_GAMES", "STATS_TAKE", "STATS_TIME", "RECAP_TIME"}
+
+// DefaultRecapTime es la hora de los recaps semanal y mensual si RECAP_TIME no está en .env
+const DefaultRecapTime = "20:00"
+
 type Config struct {
 	DiscordToken          string
 	NotificationChannelID string
@@ -19,6 +35,10 @@ type Config struct {
 	StatsMinGames         int    // mínimo de partidas por héroe para /dota stats (>= 2)
 	StatsTime             string // hora militar (HH:MM) para envío diario de stats; vacío = desactivado
 	StatsTake             int    // partidas analizadas para stats (0-100; 0 = 100)
+	RecapTime             string // hora (HH:MM) de los recaps semanal (lunes) y mensual (día 1); vacío = desactivado
+	HighlightRoleID       string // rol a mencionar en partidas destacadas; vacío = sin mención
+	SessionIdleMinutes    int    // minutos sin partidas para dar una sesión por terminada y publicar su resumen (45-1440; 0 = desactivado)
+	ConstantsDir          string // directorio con heroes.json, items.json… que sustituyen a los embebidos; vacío = solo embebidos
 }
 
 func Load() (*Config, error) {
@@ -49,38 +69,63 @@ func Load() (*Config, error) {
 
 	requireParsed := os.Getenv("PARSED") != "false" // true por defecto; solo "false" desactiva la verificación
 
-	refreshRateMinutes := 1
+	refreshRateMinutes := MinRefreshRate
 	if s := os.Getenv("REFRESH_RATE"); s != "" {
-		if n, err := strconv.Atoi(s); err == nil && n >= 1 {
+		if n, err := strconv.Atoi(s); err == nil && n >= MinRefreshRate {
 			refreshRateMinutes = n
-			if refreshRateMinutes > 60 {
-				refreshRateMinutes = 60
+			if refreshRateMinutes > MaxRefreshRate {
+				refreshRateMinutes = MaxRefreshRate
 			}
 		}
 	}
 
-	statsMinGames := 2
+	statsMinGames := MinStatsMinGames
 	if s := os.Getenv("STATS_MIN_GAMES"); s != "" {
-		if n, err := strconv.Atoi(s); err == nil && n >= 2 {
+		if n, err := strconv.Atoi(s); err == nil && n >= MinStatsMinGames {
 			statsMinGames = n
 		}
 	}
 
 	statsTime := os.Getenv("STATS_TIME") // HH:MM, ej. "20:00"; vacío = no envío automático
 
-	statsTake := 100
+	recapTime := DefaultRecapTime // HH:MM; off = sin recaps
+	if s := os.Getenv("RECAP_TIME"); s == "off" {
+		recapTime = ""
+	} else if s != "" {
+		recapTime = s
+	}
+
+	statsTake := MaxStatsTake
 	if s := os.Getenv("STATS_TAKE"); s != "" {
 		if n, err := strconv.Atoi(s); err == nil {
 			if n <= 0 {
-				statsTake = 100
-			} else if n > 100 {
-				statsTake = 100
+				statsTake = MaxStatsTake
+			} else if n > MaxStatsTake {
+				statsTake = MaxStatsTake
 			} else {
 				statsTake = n
 			}
 		}
 	}
 
+	highlightRoleID := os.Getenv("HIGHLIGHT_ROLE_ID") // vacío = no mencionar a ningún rol
+
+	// Menos de 45 minutos partiría sesiones que aún pueden continuar (el hueco máximo entre partidas es 45)
+	sessionIdleMinutes := 60
+	if s := os.Getenv("SESSION_IDLE"); s != "" {
+		if n, err := strconv.Atoi(s); err == nil && n >= 0 {
+			sessionIdleMinutes = n
+			if sessionIdleMinutes > 0 && sessionIdleMinutes < 45 {
+				sessionIdleMinutes = 45
+			}
+			if sessionIdleMinutes > 1440 {
+				sessionIdleMinutes = 1440
+			}
+		}
+	}
+
+	constantsDir := os.Getenv("CONSTANTS_DIR") // vacío = constantes embebidas en el binario
+
 	return &Config{
 		DiscordToken:          discordToken,
 		NotificationChannelID: notificationChannelID,
@@ -91,6 +136,86 @@ func Load() (*Config, error) {
 		RequireParsed:         requireParsed,
 		StatsMinGames:         statsMinGames,
 		StatsTime:             statsTime,
+		RecapTime:             recapTime,
 		StatsTake:             statsTake,
+		HighlightRoleID:       highlightRoleID,
+		SessionIdleMinutes:    sessionIdleMinutes,
+		ConstantsDir:          constantsDir,
 	}, nil
 }
+
+// Set valida value con los mismos límites que Load y lo aplica a la variable key (una de RuntimeKeys).
+// A diferencia de Load, los valores fuera de rango son un error en lugar de ajustarse.
+func (c *Config) Set(key, value string) error {
+	value = strings.TrimSpace(value)
+	switch key {
+	case "REFRESH_RATE":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < MinRefreshRate || n > MaxRefreshRate {
+			return fmt.Errorf("debe ser un entero entre %d y %d (minutos)", MinRefreshRate, MaxRefreshRate)
+		}
+		c.RefreshRateMinutes = n
+	case "PARSED":
+		parsed, err := strconv.ParseBool(value)
+		if err != nil {
+			return fmt.Errorf("debe ser true o false")
+		}
+		c.RequireParsed = parsed
+	case "STATS_MIN_GAMES":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < MinStatsMinGames {
+			return fmt.Errorf("debe ser un entero mayor o igual que %d", MinStatsMinGames)
+		}
+		c.StatsMinGames = n
+	case "STATS_TAKE":
+		n, err := strconv.Atoi(value)
+		if err != nil || n < 0 || n > MaxStatsTake {
+			return fmt.Errorf("debe ser un entero entre 0 y %d (0 = %d)", MaxStatsTake, MaxStatsTake)
+		}
+		if n == 0 {
+			n = MaxStatsTake
+		}
+		c.StatsTake = n
+	case "STATS_TIME":
+		return setDailyTime(&c.StatsTime, value)
+	case "RECAP_TIME":
+		return setDailyTime(&c.RecapTime, value)
+	default:
+		return fmt.Errorf("variable desconocida %q (usa %s)", key, strings.Join(RuntimeKeys, ", "))
+	}
+	return nil
+}
+
+// Get devuelve el valor actual de la variable key (una de RuntimeKeys) en el formato de .env
+func (c *Config) Get(key string) (string, error) {
+	switch key {
+	case "REFRESH_RATE":
+		return strconv.Itoa(c.RefreshRateMinutes), nil
+	case "PARSED":
+		return strconv.FormatBool(c.RequireParsed), nil
+	case "STATS_MIN_GAMES":
+		return strconv.Itoa(c.StatsMinGames), nil
+	case "STATS_TAKE":
+		return strconv.Itoa(c.StatsTake), nil
+	case "STATS_TIME":
+		return c.StatsTime, nil
+	case "RECAP_TIME":
+		return c.RecapTime, nil
+	default:
+		return "", fmt.Errorf("variable desconocida %q (usa %s)", key, strings.Join(RuntimeKeys, ", "))
+	}
+}
+
+// setDailyTime valida una hora diaria HH:MM (off o vacío = desactivado) y la guarda normalizada en dst
+func setDailyTime(dst *string, value string) error {
+	if value == "" || value == "off" {
+		*dst = ""
+		return nil
+	}
+	t, err := time.Parse("15:04", value)
+	if err != nil {
+		return fmt.Errorf("debe ser una hora HH:MM (ej. 20:00) u off para desactivar")
+	}
+	*dst = t.Format("15:04")
+	return nil
+}
@@ -3,26 +3,30 @@ package discord
 import (
 	"dota-discord-bot/config"
 	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
 	"dota-discord-bot/storage"
 	"errors"
 	"fmt"
 	"strconv"
 	"strings"
 	"sync"
+	"sync/atomic"
 	"time"
 
 	"github.com/bwmarrin/discordgo"
 )
 
 type Bot struct {
-	session      *discordgo.Session
-	dotaClient   *dota.Client
-	stratzClient *dota.StratzClient
-	userStore    *storage.UserStore
-	config       *config.Config
-	searchCache  map[string][]dota.SearchResponse // Cache temporal de búsquedas por usuario
-	lastStatsDay string                           // fecha (2006-01-02) del último envío diario de stats
-	statsMu      sync.Mutex                       // protege lastStatsDay
+	session          *discordgo.Session
+	dotaClient       *dota.Client
+	stratzClient     *dota.StratzClient
+	userStore        *storage.UserStore
+	config           atomic.Pointer[config.Config]    // se reemplaza entero (copy-on-write) al cambiarlo con /dota config set
+	refreshRate      chan int                         // avisa a main del nuevo REFRESH_RATE para reiniciar el ticker
+	refreshMu        sync.Mutex                       // serializa los avisos de refreshRate
+	searchCache      map[string][]dota.SearchResponse // Cache temporal de búsquedas por usuario
+	pendingTemplates pendingTemplates                 // vistas previas de /dota template esperando Guardar
+	recent           recentCache                      // partidas recientes pedidas en el ciclo del poller
 }
 
 func NewBot(cfg *config.Config, dotaClient *dota.Client, stratzClient *dota.StratzClient, userStore *storage.UserStore) (*Bot, error) {
@@ -40,9 +44,10 @@ func NewBot(cfg *config.Config, dotaClient *dota.Client, stratzClient *dota.Stra
 		dotaClient:   dotaClient,
 		stratzClient: stratzClient,
 		userStore:    userStore,
-		config:       cfg,
 		searchCache:  make(map[string][]dota.SearchResponse),
+		refreshRate:  make(chan int, 1),
 	}
+	bot.config.Store(cfg)
 
 	// Cambiar a interactionCreate para manejar slash commands
 	session.AddHandler(bot.interactionCreate)
@@ -59,6 +64,9 @@ func (b *Bot) Start() error {
 	}
 	getLogger().Info("Bot conectado exitosamente")
 
+	// Configuración guardada con /dota config set (necesita la sesión para resolver el servidor principal)
+	b.applyGuildSettings()
+
 	// Registrar comandos slash
 	if err := b.registerCommands(); err != nil {
 		getLogger().Warnf("Error registrando comandos: %v", err)
@@ -74,7 +82,7 @@ func (b *Bot) registerCommands() error {
 	// guildID := ""
 
 	// OPCIÓN 2: Por guild específico (INSTANTÁNEO)
-	guildID := b.config.ServerID
+	guildID := b.cfg().ServerID
 	if guildID == "" {
 		getLogger().Warn("SERVER_ID no configurado en .env, los comandos se registrarán globalmente (puede tardar hasta 1 hora)")
 	}
@@ -149,7 +157,467 @@ func (b *Bot) registerCommands() error {
 				{
 					Type:        discordgo.ApplicationCommandOptionSubCommand,
 					Name:        "stats",
-					Description: "Estadísticas por héroe en el parche actual (W/L, % victorias)",
+					Description: "Estadísticas por héroe (W/L, % victorias); por defecto las tuyas",
+					Options: append([]*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "usuario",
+							Description: "Usuario registrado (opcional, por defecto tú)",
+							Required:    false,
+						},
+						{
+							Type:         discordgo.ApplicationCommandOptionString,
+							Name:         "heroe",
+							Description:  "Detalle de un héroe (nombre o apodo, ej. Anti-Mage, am)",
+							Required:     false,
+							Autocomplete: true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "todos",
+							Description: "Un mensaje por cada usuario registrado",
+							Required:    false,
+						},
+					}, statsFilterOptions...),
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "match",
+					Description: "Reporte de cualquier partida por ID",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "id",
+							Description: "ID de la partida",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "jugador",
+							Description: "Jugador registrado desde cuya perspectiva ver la partida (opcional)",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "last",
+					Description: "Última partida de un jugador registrado (aunque aún no esté parseada)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "usuario",
+							Description: "Usuario registrado (opcional, por defecto tú)",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "privado",
+							Description: "Responder solo para ti (por defecto público)",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "history",
+					Description: "Últimas partidas de un jugador registrado, con páginas",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "usuario",
+							Description: "Usuario registrado (opcional, por defecto tú)",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionInteger,
+							Name:        "cantidad",
+							Description: "Partidas a cargar (1-100, por defecto 20)",
+							Required:    false,
+							MinValue:    &historyMinValue,
+							MaxValue:    historyMaxMatches,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "profile",
+					Description: "Perfil: rango, W/L histórico, racha, top héroes y forma reciente",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "usuario",
+							Description: "Usuario registrado (opcional, por defecto tú)",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "compare",
+					Description: "Comparar dos jugadores registrados lado a lado",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "a",
+							Description: "Primer jugador",
+							Required:    true,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "b",
+							Description: "Segundo jugador",
+							Required:    true,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "leaderboard",
+					Description: "Ranking de todos los jugadores registrados",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "metrica",
+							Description: "Métrica para ordenar",
+							Required:    true,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "% victorias", Value: "winrate"},
+								{Name: "KDA", Value: "kda"},
+								{Name: "GPM", Value: "gpm"},
+								{Name: "Partidas", Value: "partidas"},
+								{Name: "Racha", Value: "racha"},
+								{Name: "Rango", Value: "rango"},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "periodo",
+							Description: "Periodo (por defecto semana)",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "Día", Value: "dia"},
+								{Name: "Semana", Value: "semana"},
+								{Name: "Mes", Value: "mes"},
+							},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "synergy",
+					Description: "% victorias de dúos y stacks de registrados jugando juntos",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "usuario",
+							Description: "Solo dúos/stacks de este usuario (opcional)",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "rank",
+					Description: "Historial de rango (medalla y estrellas) de un usuario",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionUser,
+							Name:        "usuario",
+							Description: "Usuario registrado (opcional, por defecto tú)",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "template",
+					Description: "Plantilla de las notificaciones de partidas del servidor",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "show",
+							Description: "Ver la plantilla actual, su vista previa y su JSON",
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "preset",
+							Description: "Elegir una plantilla incorporada (con vista previa antes de guardar)",
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "nombre",
+									Description: "Plantilla incorporada",
+									Required:    true,
+									Choices: []*discordgo.ApplicationCommandOptionChoice{
+										{Name: "Compacta", Value: templatePresetCompact},
+										{Name: "Completa", Value: templatePresetFull},
+										{Name: "Meme", Value: templatePresetMeme},
+									},
+								},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "edit",
+							Description: "Plantilla personalizada en JSON (con vista previa antes de guardar)",
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "json",
+									Description: "JSON de la plantilla (copia el de /dota template show y modifícalo)",
+									Required:    true,
+								},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "reset",
+							Description: "Volver al formato de notificación por defecto",
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "route",
+					Description: "Reglas para enviar las notificaciones a otros canales según la partida",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "add",
+							Description: "Añadir una regla: las partidas que cumplan todas las condiciones van al canal",
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:         discordgo.ApplicationCommandOptionChannel,
+									Name:         "canal",
+									Description:  "Canal de destino",
+									Required:     true,
+									ChannelTypes: []discordgo.ChannelType{discordgo.ChannelTypeGuildText, discordgo.ChannelTypeGuildNews},
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "modo",
+									Description: "Modo de juego",
+									Required:    false,
+									Choices: []*discordgo.ApplicationCommandOptionChoice{
+										{Name: "Ranked", Value: "ranked"},
+										{Name: "Normal", Value: "normal"},
+										{Name: "Turbo", Value: "turbo"},
+										{Name: "All Pick", Value: "allpick"},
+									},
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionInteger,
+									Name:        "modo_id",
+									Description: "ID de game_mode (otros modos)",
+									Required:    false,
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionInteger,
+									Name:        "lobby_id",
+									Description: "ID de lobby_type (otros tipos de lobby)",
+									Required:    false,
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "resultado",
+									Description: "Resultado para el jugador",
+									Required:    false,
+									Choices: []*discordgo.ApplicationCommandOptionChoice{
+										{Name: "Victorias", Value: notifyResultWin},
+										{Name: "Derrotas", Value: notifyResultLoss},
+									},
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionUser,
+									Name:        "usuario",
+									Description: "Solo las partidas de este usuario",
+									Required:    false,
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "destacada",
+									Description: "Solo partidas destacadas (cualquiera o una regla concreta)",
+									Required:    false,
+									Choices:     routeHighlightChoices(),
+								},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "remove",
+							Description: "Borrar una regla",
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionInteger,
+									Name:        "numero",
+									Description: "Número de la regla en /dota route list",
+									Required:    true,
+									MinValue:    &routeMinNumber,
+								},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "list",
+							Description: "Ver las reglas en orden de evaluación",
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "quiet",
+					Description: "Horas de silencio: las partidas se agrupan en un resumen al terminar la ventana",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "set",
+							Description: "Configurar la ventana de horas de silencio",
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "desde",
+									Description: "Inicio (HH:MM, ej. 01:00)",
+									Required:    true,
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "hasta",
+									Description: "Fin (HH:MM, ej. 09:00)",
+									Required:    true,
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "zona",
+									Description: "Zona horaria IANA (ej. Europe/Madrid, America/Mexico_City)",
+									Required:    true,
+								},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "off",
+							Description: "Desactivar las horas de silencio",
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "show",
+							Description: "Ver la ventana actual y las partidas en cola",
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommandGroup,
+					Name:        "config",
+					Description: "Configuración del bot: ver o cambiar variables de .env sin reiniciar",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "get",
+							Description: "Ver el valor actual de las variables",
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "clave",
+									Description: "Variable (sin ella se muestran todas)",
+									Required:    false,
+									Choices:     configKeyChoices(),
+								},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionSubCommand,
+							Name:        "set",
+							Description: "Cambiar una variable (se aplica al momento y se guarda)",
+							Options: []*discordgo.ApplicationCommandOption{
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "clave",
+									Description: "Variable",
+									Required:    true,
+									Choices:     configKeyChoices(),
+								},
+								{
+									Type:        discordgo.ApplicationCommandOptionString,
+									Name:        "valor",
+									Description: "Nuevo valor (ej. 5, true, 20:00, off)",
+									Required:    true,
+								},
+							},
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "notify",
+					Description: "Tus preferencias de notificación de partidas (sin opciones muestra las actuales)",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "activo",
+							Description: "Notificar tus partidas",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "resultado",
+							Description: "Qué partidas notificar según el resultado",
+							Required:    false,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "Todas", Value: notifyResultAll},
+								{Name: "Victorias", Value: notifyResultWin},
+								{Name: "Derrotas", Value: notifyResultLoss},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "solo_ranked",
+							Description: "Notificar solo partidas ranked",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "solo_destacadas",
+							Description: "Notificar solo partidas destacadas",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "mencion",
+							Description: "Mencionarte en la notificación",
+							Required:    false,
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "md",
+							Description: "Enviarte además una copia por mensaje directo",
+							Required:    false,
+						},
+					},
+				},
+				{
+					Type:        discordgo.ApplicationCommandOptionSubCommand,
+					Name:        "language",
+					Description: "Idioma de las respuestas del bot",
+					Options: []*discordgo.ApplicationCommandOption{
+						{
+							Type:        discordgo.ApplicationCommandOptionString,
+							Name:        "idioma",
+							Description: "es, en o auto (idioma de tu cliente de Discord)",
+							Required:    true,
+							Choices: []*discordgo.ApplicationCommandOptionChoice{
+								{Name: "Español", Value: "es"},
+								{Name: "English", Value: "en"},
+								{Name: "Auto", Value: "auto"},
+							},
+						},
+						{
+							Type:        discordgo.ApplicationCommandOptionBoolean,
+							Name:        "servidor",
+							Description: "Cambiar el idioma por defecto del servidor (requiere Gestionar servidor)",
+							Required:    false,
+						},
+					},
 				},
 				{
 					Type:        discordgo.ApplicationCommandOptionSubCommand,
@@ -160,6 +628,9 @@ func (b *Bot) registerCommands() error {
 		},
 	}
 
+	// Descripciones en inglés para clientes en en-US/en-GB (el texto base es español)
+	localizeCommands(commands)
+
 	for _, cmd := range commands {
 		_, err := b.session.ApplicationCommandCreate(b.session.State.User.ID, guildID, cmd)
 		if err != nil {
@@ -178,15 +649,32 @@ func (b *Bot) Stop() {
 }
 
 func (b *Bot) interactionCreate(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	// Botones y select menus de mensajes enviados por el bot
+	if i.Type == discordgo.InteractionMessageComponent {
+		b.handleComponent(s, i)
+		return
+	}
+
+	// Sugerencias mientras se escribe una opción con Autocomplete (ej. heroe)
+	if i.Type == discordgo.InteractionApplicationCommandAutocomplete {
+		b.handleAutocomplete(s, i)
+		return
+	}
+
 	// Solo manejar comandos de aplicación (slash commands)
-	if i.ApplicationCommandData().Name != "dota" {
+	if i.Type != discordgo.InteractionApplicationCommand || i.ApplicationCommandData().Name != "dota" {
 		return
 	}
 
-	// Responder inmediatamente (Discord requiere respuesta en 3 segundos)
-	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+	// Responder inmediatamente (Discord requiere respuesta en 3 segundos).
+	// Si el subcomando trae privado:true, la respuesta diferida es efímera (solo la ve quien ejecuta).
+	deferred := &discordgo.InteractionResponse{
 		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
-	})
+	}
+	if isPrivateRequest(i.ApplicationCommandData().Options) {
+		deferred.Data = &discordgo.InteractionResponseData{Flags: discordgo.MessageFlagsEphemeral}
+	}
+	err := s.InteractionRespond(i.Interaction, deferred)
 	if err != nil {
 		// 10062 = Unknown interaction (token expirado o interacción ya respondida, p. ej. evento duplicado)
 		if strings.Contains(err.Error(), "10062") || strings.Contains(err.Error(), "Unknown interaction") {
@@ -199,7 +687,7 @@ func (b *Bot) interactionCreate(s *discordgo.Session, i *discordgo.InteractionCr
 
 	// Obtener el subcomando
 	if len(i.ApplicationCommandData().Options) == 0 {
-		b.sendFollowup(s, i, "❌ Comando inválido. Usa `/dota help` para ver los comandos disponibles.")
+		b.sendFollowup(s, i, b.t(i, "err.invalid_command"))
 		return
 	}
 
@@ -223,14 +711,101 @@ func (b *Bot) interactionCreate(s *discordgo.Session, i *discordgo.InteractionCr
 	case "channel":
 		b.handleChannelSlash(s, i, subcommand)
 	case "stats":
-		b.handleStatsSlash(s, i)
+		b.handleStatsSlash(s, i, subcommand)
+	case "match":
+		b.handleMatchSlash(s, i, subcommand)
+	case "last":
+		b.handleLastSlash(s, i, subcommand)
+	case "history":
+		b.handleHistorySlash(s, i, subcommand)
+	case "profile":
+		b.handleProfileSlash(s, i, subcommand)
+	case "compare":
+		b.handleCompareSlash(s, i, subcommand)
+	case "leaderboard":
+		b.handleLeaderboardSlash(s, i, subcommand)
+	case "synergy":
+		b.handleSynergySlash(s, i, subcommand)
+	case "rank":
+		b.handleRankSlash(s, i, subcommand)
+	case "template":
+		b.handleTemplateSlash(s, i, subcommand)
+	case "route":
+		b.handleRouteSlash(s, i, subcommand)
+	case "quiet":
+		b.handleQuietSlash(s, i, subcommand)
+	case "config":
+		b.handleConfigSlash(s, i, subcommand)
+	case "notify":
+		b.handleNotifySlash(s, i, subcommand)
+	case "language":
+		b.handleLanguageSlash(s, i, subcommand)
 	case "help":
 		b.handleHelpSlash(s, i)
 	default:
-		b.sendFollowup(s, i, "❌ Comando no reconocido. Usa `/dota help` para ver los comandos disponibles.")
+		b.sendFollowup(s, i, b.t(i, "err.unknown_command"))
+	}
+}
+
+// handleComponent enruta interacciones de componentes según el prefijo del custom_id (prefijo:arg1:arg2...).
+func (b *Bot) handleComponent(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	parts := strings.Split(i.MessageComponentData().CustomID, ":")
+	switch parts[0] {
+	case templateSaveCustomID, templateCancelCustomID:
+		b.handleTemplateComponent(s, i, parts[0])
+		return
+	}
+	if b.stratzClient == nil || !b.stratzClient.IsConfigured() {
+		getLogger().Debug("Stratz no configurado, componente rechazado")
+		// Sin respuesta Discord muestra "Esta interacción ha fallado"
+		if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+			Type: discordgo.InteractionResponseChannelMessageWithSource,
+			Data: &discordgo.InteractionResponseData{
+				Content: b.t(i, "err.stratz_not_configured"),
+				Flags:   discordgo.MessageFlagsEphemeral,
+			},
+		}); err != nil {
+			getLogger().Errorf("Error respondiendo a componente: %v", err)
+		}
+		return
+	}
+	switch parts[0] {
+	case historyPageCustomID:
+		b.handleHistoryPageComponent(s, i, parts[1:])
+	case historyMatchCustomID:
+		b.handleHistoryMatchComponent(s, i, parts[1:])
+	default:
+		getLogger().Debugf("Componente no reconocido: %s", parts[0])
 	}
 }
 
+// ephemeralSubcommands son los subcomandos de administración y de preferencias personales: su respuesta siempre es efímera.
+var ephemeralSubcommands = map[string]bool{"template": true, "route": true, "quiet": true, "config": true, "notify": true}
+
+// isPrivateRequest indica si el subcomando pide respuesta efímera (opción privado:true o subcomando de ephemeralSubcommands).
+func isPrivateRequest(options []*discordgo.ApplicationCommandInteractionDataOption) bool {
+	if len(options) == 0 {
+		return false
+	}
+	if ephemeralSubcommands[options[0].Name] {
+		return true
+	}
+	for _, option := range options[0].Options {
+		if option.Name == "privado" && option.Type == discordgo.ApplicationCommandOptionBoolean {
+			return option.BoolValue()
+		}
+	}
+	return false
+}
+
+// interactionUser devuelve el usuario de Discord que ejecuta la interacción (guild o DM).
+func interactionUser(i *discordgo.InteractionCreate) *discordgo.User {
+	if i.Member != nil && i.Member.User != nil {
+		return i.Member.User
+	}
+	return i.User
+}
+
 func (b *Bot) sendFollowup(s *discordgo.Session, i *discordgo.InteractionCreate, content string) {
 	_, err := s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
 		Content: content,
@@ -240,9 +815,16 @@ func (b *Bot) sendFollowup(s *discordgo.Session, i *discordgo.InteractionCreate,
 	}
 }
 
-func (b *Bot) sendFollowupEmbed(s *discordgo.Session, i *discordgo.InteractionCreate, embed *discordgo.MessageEmbed) {
+// sendFollowupEmbed envía un embed como followup; files son adjuntos opcionales (ej. attachment:// en el embed).
+func (b *Bot) sendFollowupEmbed(s *discordgo.Session, i *discordgo.InteractionCreate, embed *discordgo.MessageEmbed, files ...*discordgo.File) {
+	b.sendFollowupEmbeds(s, i, []*discordgo.MessageEmbed{embed}, files)
+}
+
+// sendFollowupEmbeds envía varios embeds (ej. partida + scoreboard) con sus adjuntos como un solo followup.
+func (b *Bot) sendFollowupEmbeds(s *discordgo.Session, i *discordgo.InteractionCreate, embeds []*discordgo.MessageEmbed, files []*discordgo.File) {
 	_, err := s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
-		Embeds: []*discordgo.MessageEmbed{embed},
+		Embeds: embeds,
+		Files:  files,
 	})
 	if err != nil {
 		getLogger().Errorf("Error enviando followup embed: %v", err)
@@ -261,30 +843,30 @@ func (b *Bot) handleSearchSlash(s *discordgo.Session, i *discordgo.InteractionCr
 	}
 
 	if query == "" {
-		b.sendFollowup(s, i, "❌ Uso: `/dota search nombre:<nombre>`")
+		b.sendFollowup(s, i, b.t(i, "search.usage"))
 		return
 	}
 
 	getLogger().Debugf("Buscando jugadores: %s", query)
 
 	if b.stratzClient == nil || !b.stratzClient.IsConfigured() {
-		b.sendFollowup(s, i, "❌ Stratz no está configurado.")
+		b.sendFollowup(s, i, b.t(i, "err.stratz_not_configured"))
 		return
 	}
 
 	results, err := b.stratzClient.SearchPlayers(query)
 	if err != nil {
 		if errors.Is(err, dota.ErrSearchNotSupported) {
-			b.sendFollowup(s, i, "🔍 La búsqueda por nombre no está disponible con Stratz.\n\nUsa **Steam ID** (account_id) directamente:\n`/dota register account_id:<tu_steam_id>`\n\nPuedes encontrar tu Steam ID en https://stratz.com (busca tu perfil o partidas).")
+			b.sendFollowup(s, i, b.t(i, "search.not_supported"))
 			return
 		}
 		getLogger().Errorf("Error buscando jugadores: %v", err)
-		b.sendFollowup(s, i, fmt.Sprintf("❌ Error en la búsqueda: %v", err))
+		b.sendFollowup(s, i, b.t(i, "search.error", err))
 		return
 	}
 
 	if len(results) == 0 {
-		b.sendFollowup(s, i, "❌ No se encontraron jugadores con ese nombre")
+		b.sendFollowup(s, i, b.t(i, "search.no_results"))
 		return
 	}
 
@@ -301,22 +883,23 @@ func (b *Bot) handleSearchSlash(s *discordgo.Session, i *discordgo.InteractionCr
 	b.searchCache[userID] = results
 
 	// Construir mensaje
+	lang := b.lang(i)
 	var msg strings.Builder
-	msg.WriteString("🔍 **Resultados de búsqueda:**\n\n")
+	msg.WriteString(i18n.T(lang, "search.title"))
 
 	for idx, result := range results {
 		personaname := result.Personaname
 		if personaname == "" {
-			personaname = "Sin nombre"
+			personaname = i18n.T(lang, "search.no_name")
 		}
 		msg.WriteString(fmt.Sprintf("**%d.** %s (ID: %d)\n", idx+1, personaname, result.AccountID))
 		if result.LastMatchTime != "" {
-			msg.WriteString(fmt.Sprintf("   Última partida: %s\n", result.LastMatchTime))
+			msg.WriteString(i18n.T(lang, "search.last_match", result.LastMatchTime))
 		}
 		msg.WriteString("\n")
 	}
 
-	msg.WriteString("Usa `/dota register account_id:<número>` para registrar un jugador\nO `/dota register account_id:<número> usuario:@amigo` para registrar a otro usuario")
+	msg.WriteString(i18n.T(lang, "search.footer"))
 
 	b.sendFollowup(s, i, msg.String())
 }
@@ -335,7 +918,7 @@ func (b *Bot) handleRegisterSlash(s *discordgo.Session, i *discordgo.Interaction
 	}
 
 	if accountIDInput == "" {
-		b.sendFollowup(s, i, "❌ Uso: `/dota register account_id:<account_id>` o `/dota register account_id:<número>` después de una búsqueda")
+		b.sendFollowup(s, i, b.t(i, "register.usage"))
 		return
 	}
 
@@ -353,7 +936,7 @@ func (b *Bot) handleRegisterSlash(s *discordgo.Session, i *discordgo.Interaction
 			accountID = strconv.Itoa(results[num-1].AccountID)
 			delete(b.searchCache, cacheKey) // Limpiar cache
 		} else {
-			b.sendFollowup(s, i, "❌ No hay resultados de búsqueda disponibles. Usa `/dota search nombre:<nombre>` primero.")
+			b.sendFollowup(s, i, b.t(i, "register.no_search"))
 			return
 		}
 	} else {
@@ -361,29 +944,29 @@ func (b *Bot) handleRegisterSlash(s *discordgo.Session, i *discordgo.Interaction
 		accountID = accountIDInput
 		// Validar que sea numérico
 		if _, err := strconv.Atoi(accountID); err != nil {
-			b.sendFollowup(s, i, "❌ El account_id debe ser un número")
+			b.sendFollowup(s, i, b.t(i, "register.not_number"))
 			return
 		}
 	}
 
 	// Verificar que el jugador existe (solo Stratz)
 	if b.stratzClient == nil || !b.stratzClient.IsConfigured() {
-		b.sendFollowup(s, i, "❌ Stratz no está configurado.")
+		b.sendFollowup(s, i, b.t(i, "err.stratz_not_configured"))
 		return
 	}
 	accountIDInt, errParse := strconv.ParseInt(accountID, 10, 64)
 	if errParse != nil {
-		b.sendFollowup(s, i, "❌ account_id inválido")
+		b.sendFollowup(s, i, b.t(i, "err.invalid_account_id"))
 		return
 	}
 	profileStratz, err := b.stratzClient.GetPlayerProfile(accountIDInt)
 	if err != nil {
 		getLogger().Errorf("Error obteniendo perfil Stratz: %v", err)
-		b.sendFollowup(s, i, fmt.Sprintf("❌ Error verificando jugador (Stratz): %v", err))
+		b.sendFollowup(s, i, b.t(i, "register.verify_error", err))
 		return
 	}
 	if profileStratz == nil {
-		b.sendFollowup(s, i, "❌ No se encontró el jugador en Stratz")
+		b.sendFollowup(s, i, b.t(i, "register.not_found"))
 		return
 	}
 
@@ -405,7 +988,7 @@ func (b *Bot) handleRegisterSlash(s *discordgo.Session, i *discordgo.Interaction
 			userID = i.User.ID
 			discordUsername = i.User.Username
 		} else {
-			b.sendFollowup(s, i, "❌ No se pudo identificar al usuario")
+			b.sendFollowup(s, i, b.t(i, "err.user_unknown"))
 			return
 		}
 		getLogger().Debugf("Registrando usuario que ejecuta el comando: %s (%s)", discordUsername, userID)
@@ -414,16 +997,16 @@ func (b *Bot) handleRegisterSlash(s *discordgo.Session, i *discordgo.Interaction
 	// Registrar usuario
 	if err := b.userStore.Set(userID, accountID); err != nil {
 		getLogger().Errorf("Error guardando usuario: %v", err)
-		b.sendFollowup(s, i, "❌ Error guardando registro")
+		b.sendFollowup(s, i, b.t(i, "register.save_error"))
 		return
 	}
 
 	personaname := profileStratz.Name
 	if personaname == "" {
-		personaname = "Jugador"
+		personaname = b.t(i, "player.default")
 	}
 
-	b.sendFollowup(s, i, fmt.Sprintf("✅ **%s** (Discord) asociado con **%s** (Dota 2)\nID de Dota: %s", discordUsername, personaname, accountID))
+	b.sendFollowup(s, i, b.t(i, "register.ok", discordUsername, personaname, accountID))
 	getLogger().Infof("Usuario Discord %s (%s) registrado con account_id %s", userID, discordUsername, accountID)
 }
 
@@ -439,18 +1022,18 @@ func (b *Bot) handleChannelSlash(s *discordgo.Session, i *discordgo.InteractionC
 	}
 
 	if channelID == "" {
-		b.sendFollowup(s, i, "❌ Canal no válido")
+		b.sendFollowup(s, i, b.t(i, "channel.invalid"))
 		return
 	}
 
 	// Guardar canal
 	if err := b.userStore.SetChannel(channelID); err != nil {
 		getLogger().Errorf("Error guardando canal: %v", err)
-		b.sendFollowup(s, i, "❌ Error guardando canal")
+		b.sendFollowup(s, i, b.t(i, "channel.save_error"))
 		return
 	}
 
-	b.sendFollowup(s, i, fmt.Sprintf("✅ Canal de notificaciones configurado: <#%s>", channelID))
+	b.sendFollowup(s, i, b.t(i, "channel.ok", channelID))
 	getLogger().Infof("Canal de notificaciones configurado: %s", channelID)
 }
 
@@ -475,8 +1058,39 @@ func (b *Bot) getPlayerNameAndAvatar(accountID string, accountIDInt int64) (play
 	return playerName, avatarURL
 }
 
+// buildPlayerProfile arma el perfil usado por la notificación de partida (Stratz + fallback OpenDota para nombre y avatar).
+// profileStratz puede ser nil (p. ej. si GetPlayerProfile falló).
+func (b *Bot) buildPlayerProfile(accountID string, accountIDInt int64, profileStratz *dota.StratzPlayerStats) *dota.PlayersResponse {
+	var profile *dota.PlayersResponse
+	if profileStratz != nil {
+		profile = &dota.PlayersResponse{}
+		profile.Profile.Personaname = profileStratz.Name
+		profile.Profile.Avatarfull = profileStratz.Avatar
+		profile.Profile.AccountID = int(profileStratz.SteamAccountID)
+		profile.RankBracket = profileStratz.RankBracket
+	}
+	// Nombre y avatar: fallback a OpenDota si Stratz no devuelve
+	if b.dotaClient != nil {
+		profileOD, errOD := b.dotaClient.GetPlayerProfile(accountID)
+		if errOD == nil && profileOD != nil {
+			if profile == nil {
+				profile = &dota.PlayersResponse{}
+				profile.Profile.AccountID = int(accountIDInt)
+			}
+			if profileOD.Profile.Personaname != "" && profile.Profile.Personaname == "" {
+				profile.Profile.Personaname = profileOD.Profile.Personaname
+			}
+			if profileOD.Profile.Avatarfull != "" && profile.Profile.Avatarfull == "" {
+				profile.Profile.Avatarfull = profileOD.Profile.Avatarfull
+			}
+		}
+	}
+	return profile
+}
+
 // buildStatsEmbed construye el embed de estadísticas por héroe (W/L, %). playerName en título; avatarURL opcional (Author + Thumbnail como en notificación).
-func (b *Bot) buildStatsEmbed(heroStats []dota.StratzHeroStats, minGames, take int, playerName, avatarURL string) *discordgo.MessageEmbed {
+// filterText describe los filtros aplicados (rol, línea, modo, fechas) y se muestra en el footer; vacío = sin filtros.
+func (b *Bot) buildStatsEmbed(lang i18n.Lang, heroStats []dota.StratzHeroStats, minGames, take int, playerName, avatarURL, filterText string) *discordgo.MessageEmbed {
 	var red, yellow, green []string
 	for _, h := range heroStats {
 		winPct := 0.0
@@ -511,14 +1125,18 @@ func (b *Bot) buildStatsEmbed(heroStats []dota.StratzHeroStats, minGames, take i
 	}
 	displayName := playerName
 	if displayName == "" {
-		displayName = "Jugador"
+		displayName = i18n.T(lang, "player.default")
+	}
+	title := i18n.T(lang, "stats.title", displayName)
+	footer := i18n.T(lang, "stats.footer", take, minGames)
+	if filterText != "" {
+		footer = i18n.T(lang, "stats.footer_filtered", take, minGames, filterText)
 	}
-	title := fmt.Sprintf("📊 Estadísticas por héroe — %s", displayName)
 	embed := &discordgo.MessageEmbed{
 		Title:       title,
 		Description: description,
 		Color:       0x3498db,
-		Footer:      &discordgo.MessageEmbedFooter{Text: fmt.Sprintf("%d partidas analizadas • ≥%d partidas por héroe • Stratz", take, minGames)},
+		Footer:      &discordgo.MessageEmbedFooter{Text: footer},
 	}
 	if avatarURL != "" {
 		embed.Author = &discordgo.MessageEmbedAuthor{
@@ -530,18 +1148,95 @@ func (b *Bot) buildStatsEmbed(heroStats []dota.StratzHeroStats, minGames, take i
 	return embed
 }
 
-func (b *Bot) handleStatsSlash(s *discordgo.Session, i *discordgo.InteractionCreate) {
+// handleStatsSlash muestra estadísticas por héroe: /dota stats [usuario:@usuario] [heroe:<nombre>] [todos:true] [filtros].
+// Por defecto muestra al usuario que ejecuta el comando; con heroe, el detalle de ese héroe; con todos:true, un embed por registrado.
+func (b *Bot) handleStatsSlash(s *discordgo.Session, i *discordgo.InteractionCreate, subcommand *discordgo.ApplicationCommandInteractionDataOption) {
 	if b.stratzClient == nil || !b.stratzClient.IsConfigured() {
-		b.sendFollowup(s, i, "❌ Stratz no está configurado.")
+		b.sendFollowup(s, i, b.t(i, "err.stratz_not_configured"))
+		return
+	}
+	filter, filterText, errMsg := parseStatsFilter(b.lang(i), subcommand.Options)
+	if errMsg != "" {
+		b.sendFollowup(s, i, errMsg)
+		return
+	}
+
+	targetUser := interactionUser(i)
+	heroQuery := ""
+	all := false
+	for _, option := range subcommand.Options {
+		switch option.Name {
+		case "usuario":
+			targetUser = option.UserValue(s)
+		case "heroe":
+			heroQuery = option.StringValue()
+		case "todos":
+			all = option.BoolValue()
+		}
+	}
+
+	if all {
+		b.sendStatsForAllUsers(s, i, filter, filterText)
+		return
+	}
+
+	if targetUser == nil {
+		b.sendFollowup(s, i, b.t(i, "err.user_unknown"))
+		return
+	}
+	accountIDInt, errMsg := b.registeredAccount(b.lang(i), targetUser)
+	if errMsg != "" {
+		b.sendFollowup(s, i, errMsg)
+		return
+	}
+	accountID := strconv.FormatInt(accountIDInt, 10)
+	minGames := b.cfg().StatsMinGames
+	take := b.cfg().StatsTake
+	playerName, avatarURL := b.getPlayerNameAndAvatar(accountID, accountIDInt)
+
+	if heroQuery != "" {
+		heroID, ok := b.dotaClient.FindHeroID(heroQuery)
+		if !ok {
+			b.sendFollowup(s, i, b.t(i, "stats.hero_not_found", heroQuery))
+			return
+		}
+		filter.HeroIDs = []int{heroID}
+		matches, err := b.stratzClient.GetPlayerRecentMatchesFiltered(accountIDInt, take, filter)
+		if err != nil {
+			getLogger().Errorf("stats: GetPlayerRecentMatches (héroe %d) para %s: %v", heroID, accountID, err)
+			b.sendFollowup(s, i, b.t(i, "err.matches", err))
+			return
+		}
+		b.sendFollowupEmbed(s, i, b.buildHeroDetailEmbed(b.lang(i), heroID, matches, accountIDInt, take, playerName, avatarURL, filterText))
+		return
+	}
+
+	heroStats, err := b.stratzClient.GetPlayerHeroStatsFiltered(accountIDInt, minGames, take, filter)
+	if err != nil {
+		getLogger().Errorf("stats: GetPlayerHeroStats para %s: %v", accountID, err)
+		b.sendFollowup(s, i, b.t(i, "stats.error", err))
 		return
 	}
+	if len(heroStats) == 0 {
+		msg := b.t(i, "stats.no_heroes", targetUser.Username, minGames, take)
+		if filterText != "" {
+			msg += b.t(i, "stats.filters", filterText)
+		}
+		b.sendFollowup(s, i, msg)
+		return
+	}
+	b.sendFollowupEmbed(s, i, b.buildStatsEmbed(b.lang(i), heroStats, minGames, take, playerName, avatarURL, filterText))
+}
+
+// sendStatsForAllUsers envía un embed de estadísticas por héroe por cada usuario registrado (modo todos:true).
+func (b *Bot) sendStatsForAllUsers(s *discordgo.Session, i *discordgo.InteractionCreate, filter dota.MatchFilter, filterText string) {
 	users := b.userStore.GetAll()
 	if len(users) == 0 {
-		b.sendFollowup(s, i, "❌ No hay usuarios registrados. Usa `/dota register account_id:<tu_steam_id>` para registrar jugadores.")
+		b.sendFollowup(s, i, b.t(i, "err.no_users"))
 		return
 	}
-	minGames := b.config.StatsMinGames
-	take := b.config.StatsTake
+	minGames := b.cfg().StatsMinGames
+	take := b.cfg().StatsTake
 	getLogger().Debugf("stats: mostrando %d usuario(s) registrado(s)", len(users))
 	sent := 0
 	for _, accountID := range users {
@@ -551,7 +1246,7 @@ func (b *Bot) handleStatsSlash(s *discordgo.Session, i *discordgo.InteractionCre
 			continue
 		}
 		playerName, avatarURL := b.getPlayerNameAndAvatar(accountID, accountIDInt)
-		heroStats, err := b.stratzClient.GetPlayerHeroStats(accountIDInt, minGames, take)
+		heroStats, err := b.stratzClient.GetPlayerHeroStatsFiltered(accountIDInt, minGames, take, filter)
 		if err != nil {
 			getLogger().Errorf("stats: GetPlayerHeroStats para %s: %v", accountID, err)
 			continue
@@ -560,48 +1255,137 @@ func (b *Bot) handleStatsSlash(s *discordgo.Session, i *discordgo.InteractionCre
 			getLogger().Debugf("stats: sin héroes con ≥%d partidas para %s", minGames, accountID)
 			continue
 		}
-		embed := b.buildStatsEmbed(heroStats, minGames, take, playerName, avatarURL)
+		embed := b.buildStatsEmbed(b.lang(i), heroStats, minGames, take, playerName, avatarURL, filterText)
 		b.sendFollowupEmbed(s, i, embed)
 		sent++
 		time.Sleep(500 * time.Millisecond) // evitar rate limit entre followups
 	}
 	if sent == 0 {
-		b.sendFollowup(s, i, fmt.Sprintf("Ningún jugador registrado tiene héroes con al menos %d partidas en las últimas %d partidas analizadas.", minGames, take))
+		msg := b.t(i, "stats.no_heroes_all", minGames, take)
+		if filterText != "" {
+			msg += b.t(i, "stats.filters", filterText)
+		}
+		b.sendFollowup(s, i, msg)
 	}
 }
 
+// buildHeroDetailEmbed construye el detalle de un héroe para un jugador: partidas, W/L, K/D/A y GPM promedio y última vez jugado.
+func (b *Bot) buildHeroDetailEmbed(lang i18n.Lang, heroID int, matches []dota.StratzMatch, accountID int64, take int, playerName, avatarURL, filterText string) *discordgo.MessageEmbed {
+	heroName := b.dotaClient.GetHeroName(heroID)
+	displayName := playerName
+	if displayName == "" {
+		displayName = i18n.T(lang, "player.default")
+	}
+	heroIcon := b.dotaClient.GetHeroIconURL(heroID)
+	if heroIcon == "" {
+		heroIcon = dota.GetHeroImageURLStratz(heroID)
+	}
+
+	summary := dota.SummarizePlayerMatches(matches, accountID)
+	footer := i18n.T(lang, "hero.footer", take, heroName)
+	if filterText != "" {
+		footer = i18n.T(lang, "hero.footer_filtered", take, heroName, filterText)
+	}
+	embed := &discordgo.MessageEmbed{
+		Title:     fmt.Sprintf("📊 %s — %s", heroName, displayName),
+		Color:     0x3498db,
+		Thumbnail: &discordgo.MessageEmbedThumbnail{URL: heroIcon},
+		Footer:    &discordgo.MessageEmbedFooter{Text: footer},
+	}
+	if avatarURL != "" {
+		embed.Author = &discordgo.MessageEmbedAuthor{
+			Name:    displayName,
+			IconURL: avatarURL,
+		}
+	}
+	if summary.Games == 0 {
+		embed.Description = i18n.T(lang, "hero.no_games", heroName, take)
+		return embed
+	}
+
+	lastPlayed := "—"
+	for idx := range matches {
+		if dota.FindStratzPlayer(&matches[idx], accountID) != nil {
+			lastPlayed = fmt.Sprintf("<t:%d:R>", matches[idx].StartDateTime)
+			break
+		}
+	}
+	embed.Fields = []*discordgo.MessageEmbedField{
+		{
+			Name:   i18n.T(lang, "hero.games"),
+			Value:  strconv.Itoa(summary.Games),
+			Inline: true,
+		},
+		{
+			Name:   "W/L",
+			Value:  fmt.Sprintf("%d-%d (%.1f%%)", summary.Wins, summary.Losses(), summary.WinRate()),
+			Inline: true,
+		},
+		{
+			Name:   i18n.T(lang, "hero.last_played"),
+			Value:  lastPlayed,
+			Inline: true,
+		},
+		{
+			Name:   i18n.T(lang, "hero.avg_kda"),
+			Value:  fmt.Sprintf("%.1f/%.1f/%.1f (%.2f KDA)", summary.AvgKills(), summary.AvgDeaths(), summary.AvgAssists(), summary.KDA()),
+			Inline: true,
+		},
+		{
+			Name:   "GPM/XPM",
+			Value:  fmt.Sprintf("%.0f / %.0f", summary.AvgGPM(), summary.AvgXPM()),
+			Inline: true,
+		},
+	}
+	if info, ok := b.dotaClient.Constants().HeroInfo(heroID); ok {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   i18n.T(lang, "hero.info"),
+			Value:  formatHeroInfo(lang, info),
+			Inline: false,
+		})
+	}
+	return embed
+}
+
+// helpCommands son las entradas de /dota help: uso del comando y clave de su descripción en i18n
+var helpCommands = []struct {
+	usage string
+	key   string
+}{
+	{"/dota search nombre:<nombre>", "help.search"},
+	{"/dota register account_id:<id> [usuario:@amigo]", "help.register"},
+	{"/dota channel canal:<#canal>", "help.channel"},
+	{"/dota stats [usuario] [heroe] [todos] [rol] [linea] [modo] [desde] [hasta] [parche]", "help.stats"},
+	{"/dota match id:<match_id> [jugador:@usuario]", "help.match"},
+	{"/dota last [usuario:@usuario] [privado:true]", "help.last"},
+	{"/dota history [usuario:@usuario] [cantidad:N]", "help.history"},
+	{"/dota profile [usuario:@usuario]", "help.profile"},
+	{"/dota compare a:@usuario b:@usuario", "help.compare"},
+	{"/dota leaderboard metrica:<métrica> [periodo:<dia|semana|mes>]", "help.leaderboard"},
+	{"/dota synergy [usuario:@usuario]", "help.synergy"},
+	{"/dota rank [usuario:@usuario]", "help.rank"},
+	{"/dota template show|preset|edit|reset", "help.template"},
+	{"/dota route add|remove|list", "help.route"},
+	{"/dota quiet set|off|show", "help.quiet"},
+	{"/dota config get [clave] | set clave:<variable> valor:<valor>", "help.config"},
+	{"/dota notify [activo] [resultado] [solo_ranked] [solo_destacadas] [mencion] [md]", "help.notify"},
+	{"/dota language idioma:<es|en|auto> [servidor:true]", "help.language"},
+	{"/dota help", "help.help"},
+}
+
 func (b *Bot) handleHelpSlash(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	lang := b.lang(i)
 	embed := &discordgo.MessageEmbed{
-		Title:       "🎮 Comandos del Bot de Dota 2",
-		Description: "Comandos disponibles. Usa register para asociar un Discord ID con un ID de Dota.",
+		Title:       i18n.T(lang, "help.title"),
+		Description: i18n.T(lang, "help.description"),
 		Color:       0x3498db,
-		Fields: []*discordgo.MessageEmbedField{
-			{
-				Name:   "/dota search nombre:<nombre>",
-				Value:  "Buscar jugadores por nombre de Steam. Devuelve hasta 10 resultados numerados.\n**Ejemplo:** `/dota search nombre:Desp4irs`",
-				Inline: false,
-			},
-			{
-				Name:   "/dota register account_id:<id> [usuario:@amigo]",
-				Value:  "Asocia el ID de Dota a un usuario de Discord y lo guarda en `data/users.json`.\n- Si omites `usuario`, te registras tú.\n- Usa número tras una búsqueda (1-10) o ID directo.\n**Ejemplos:** `/dota register account_id:136201811` · `/dota register account_id:1` · `/dota register account_id:136201811 usuario:@amigo`",
-				Inline: false,
-			},
-			{
-				Name:   "/dota channel canal:<#canal>",
-				Value:  "Configura el canal para notificaciones automáticas de nuevas partidas.\n**Ejemplo:** `/dota channel canal:#dota-updates`",
-				Inline: false,
-			},
-			{
-				Name:   "/dota stats",
-				Value:  "Un mensaje por cada usuario registrado: estadísticas por héroe (W/L, %) con ≥STATS_MIN_GAMES partidas en las últimas STATS_TAKE partidas. Colores: 🔴 ≤40%, 🟡 40-50%, 🟢 ≥50%.",
-				Inline: false,
-			},
-			{
-				Name:   "/dota help",
-				Value:  "Mostrar esta ayuda",
-				Inline: false,
-			},
-		},
+	}
+	for _, cmd := range helpCommands {
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name:   cmd.usage,
+			Value:  i18n.T(lang, cmd.key),
+			Inline: false,
+		})
 	}
 
 	b.sendFollowupEmbed(s, i, embed)
@@ -834,7 +1618,7 @@ func (b *Bot) SendWelcomeMessage() error {
 	channelID, err := b.userStore.GetChannel()
 	if err != nil || channelID == "" {
 		// Usar canal por defecto de la configuración si está disponible
-		channelID = b.config.NotificationChannelID
+		channelID = b.cfg().NotificationChannelID
 		if channelID == "" {
 			getLogger().Info("No hay canal configurado, omitiendo mensaje de bienvenida")
 			return nil
@@ -849,39 +1633,40 @@ func (b *Bot) SendWelcomeMessage() error {
 		return fmt.Errorf("ID de canal inválido: %s (debe ser un número de Discord)", channelID)
 	}
 
+	lang := b.channelLang(channelID)
 	embed := &discordgo.MessageEmbed{
-		Title:       "🤖 Bot de Dota 2 - ¡En línea!",
-		Description: "El bot está funcionando y monitoreando partidas. Aquí están los comandos disponibles:",
+		Title:       i18n.T(lang, "welcome.title"),
+		Description: i18n.T(lang, "welcome.description"),
 		Color:       0x3498db,
 		Fields: []*discordgo.MessageEmbedField{
 			{
 				Name:   "1️⃣ /dota help",
-				Value:  "Muestra esta lista completa de comandos.",
+				Value:  i18n.T(lang, "welcome.help"),
 				Inline: false,
 			},
 			{
 				Name:   "2️⃣ /dota search nombre:<nombre>",
-				Value:  "Busca jugadores de Dota 2 por nombre de Steam. Devuelve hasta 10 resultados numerados.\n**Ejemplo:** `/dota search nombre:Desp4irs`",
+				Value:  i18n.T(lang, "welcome.search"),
 				Inline: false,
 			},
 			{
 				Name:   "3️⃣ /dota register account_id:<id> [usuario:@amigo]",
-				Value:  "Asocia un usuario de Discord con un ID de Dota 2 y lo guarda en `data/users.json`.\n- Si omites `usuario`, se registra quien ejecuta el comando.\n- Puedes registrar a un amigo con `usuario:@amigo`.\n**Ejemplos:** `/dota register account_id:136201811` · `/dota register account_id:1` · `/dota register account_id:136201811 usuario:@amigo`",
+				Value:  i18n.T(lang, "welcome.register"),
 				Inline: false,
 			},
 			{
 				Name:   "4️⃣ /dota channel canal:<#canal>",
-				Value:  "Configura el canal para notificaciones automáticas de nuevas partidas.\n**Ejemplo:** `/dota channel canal:#dota-updates`",
+				Value:  i18n.T(lang, "welcome.channel"),
 				Inline: false,
 			},
 			{
 				Name:   "5️⃣ /dota stats",
-				Value:  "Estadísticas por héroe en el parche actual: W/L y % victorias (héroes con ≥10 partidas).",
+				Value:  i18n.T(lang, "welcome.stats"),
 				Inline: false,
 			},
 		},
 		Footer: &discordgo.MessageEmbedFooter{
-			Text: "El bot verifica nuevas partidas cada 10 minutos automáticamente",
+			Text: i18n.T(lang, "welcome.footer"),
 		},
 	}
 
@@ -907,7 +1692,7 @@ func (b *Bot) CheckForNewMatches() error {
 	channelID, err := b.userStore.GetChannel()
 	if err != nil || channelID == "" {
 		// Usar canal por defecto de la configuración si está disponible
-		channelID = b.config.NotificationChannelID
+		channelID = b.cfg().NotificationChannelID
 		if channelID == "" {
 			getLogger().Debug("No hay canal de notificaciones configurado")
 			return nil
@@ -920,11 +1705,20 @@ func (b *Bot) CheckForNewMatches() error {
 		return nil
 	}
 
+	// Resúmenes de horas de silencio (/dota quiet) cuya ventana ya terminó
+	b.flushDigests(channelID)
+
 	if b.stratzClient == nil || !b.stratzClient.IsConfigured() {
 		getLogger().Debug("Stratz no configurado, omitiendo verificación de partidas")
 		return nil
 	}
 
+	b.beginRecentCache()
+	defer b.endRecentCache()
+
+	// Partida más reciente de cada registrado, para detectar el final de las sesiones de juego
+	latest := make(map[string]dota.StratzMatch)
+
 	for discordID, accountID := range users {
 		lastMatchID, hasLastMatch := b.userStore.GetLastMatch(discordID)
 
@@ -934,8 +1728,9 @@ func (b *Bot) CheckForNewMatches() error {
 			continue
 		}
 
-		// Partidas recientes desde Stratz
-		matches, err := b.stratzClient.GetPlayerRecentMatches(accountIDInt, 5)
+		// Partidas recientes desde Stratz: se piden con el take más grande del ciclo (reglas de destacada) para que
+		// destacadas, racha, W/L con el héroe y sesiones las reutilicen desde la caché sin otra petición
+		matches, err := b.recentMatches(accountIDInt, historyMaxMatches)
 		if err != nil {
 			getLogger().Errorf("Error obteniendo partidas Stratz para %s: %v", accountID, err)
 			continue
@@ -946,6 +1741,7 @@ func (b *Bot) CheckForNewMatches() error {
 		}
 
 		latestStratzMatch := matches[0]
+		latest[discordID] = latestStratzMatch
 		if hasLastMatch && latestStratzMatch.ID == lastMatchID {
 			continue
 		}
@@ -960,7 +1756,7 @@ func (b *Bot) CheckForNewMatches() error {
 		}
 
 		// Si PARSED=true: solo notificar cuando la partida esté parseada (parsedDateTime > 0)
-		if b.config.RequireParsed && matchDetailsStratz != nil && !dota.IsMatchParsed(matchDetailsStratz) {
+		if b.cfg().RequireParsed && matchDetailsStratz != nil && !dota.IsMatchParsed(matchDetailsStratz) {
 			parsedVal := "null"
 			if matchDetailsStratz.ParsedDateTime != nil {
 				parsedVal = strconv.FormatInt(*matchDetailsStratz.ParsedDateTime, 10)
@@ -1001,137 +1797,199 @@ func (b *Bot) CheckForNewMatches() error {
 			continue
 		}
 
-		var profile *dota.PlayersResponse
-		if profileStratz != nil {
-			profile = &dota.PlayersResponse{}
-			profile.Profile.Personaname = profileStratz.Name
-			profile.Profile.Avatarfull = profileStratz.Avatar
-			profile.Profile.AccountID = int(profileStratz.SteamAccountID)
-			profile.RankBracket = profileStratz.RankBracket
-		}
-		// Nombre y avatar: fallback a OpenDota si Stratz no devuelve
-		if b.dotaClient != nil {
-			profileOD, errOD := b.dotaClient.GetPlayerProfile(accountID)
-			if errOD == nil && profileOD != nil {
-				if profile == nil {
-					profile = &dota.PlayersResponse{}
-					profile.Profile.AccountID = int(accountIDInt)
-					if profileStratz != nil {
-						profile.Profile.Personaname = profileStratz.Name
-						profile.RankBracket = profileStratz.RankBracket
-					}
-				}
-				if profileOD.Profile.Personaname != "" && profile.Profile.Personaname == "" {
-					profile.Profile.Personaname = profileOD.Profile.Personaname
-				}
-				if profileOD.Profile.Avatarfull != "" && profile.Profile.Avatarfull == "" {
-					profile.Profile.Avatarfull = profileOD.Profile.Avatarfull
-				}
-			}
-		}
+		profile := b.buildPlayerProfile(accountID, accountIDInt, profileStratz)
 
-		if err := b.sendMatchNotification(channelID, matchDetails, player, profile, accountID); err != nil {
-			getLogger().Errorf("Error enviando notificación: %v", err)
-			continue
+		// Preferencias del usuario (/dota notify): la partida se marca como vista aunque no se notifique
+		prefs := b.userStore.GetNotifyPrefs(discordID)
+		var highlights []dota.Highlight
+		if !prefs.Disabled {
+			highlights = b.detectMatchHighlights(matchDetails, player, accountID)
+		}
+		won := b.dotaClient.IsWinFromPlayer(*player, matchDetails.RadiantWin)
+		if reason := notifySkipReason(prefs, matchDetails, won, highlights); reason != "" {
+			getLogger().Infof("Partida %d de %s sin notificar por sus preferencias (%s)", latestStratzMatch.ID, accountID, reason)
+		} else if guildID := b.channelGuild(channelID); b.isQuiet(guildID, time.Now()) {
+			// Horas de silencio (/dota quiet): se encola para el resumen al terminar la ventana
+			if err := b.enqueueDigest(guildID, discordID, accountID, matchDetails, player, profile, won); err != nil {
+				getLogger().Errorf("Error encolando partida %d para el resumen: %v", latestStratzMatch.ID, err)
+				continue
+			}
+			getLogger().Infof("Partida %d de %s encolada por horas de silencio", latestStratzMatch.ID, accountID)
+		} else {
+			// Reglas de enrutado del servidor (/dota route); si el canal enrutado falla, canal de notificaciones
+			target := b.routeChannel(channelID, matchDetails, won, discordID, highlights)
+			err := b.sendMatchNotification(target, matchDetails, player, profile, accountID, highlights, discordID, prefs)
+			if err != nil && target != channelID {
+				getLogger().Warnf("Error enviando notificación al canal enrutado %s, usando el canal de notificaciones: %v", target, err)
+				err = b.sendMatchNotification(channelID, matchDetails, player, profile, accountID, highlights, discordID, prefs)
+			}
+			if err != nil {
+				getLogger().Errorf("Error enviando notificación: %v", err)
+				continue
+			}
 		}
 
 		if err := b.userStore.SetLastMatch(discordID, latestStratzMatch.ID); err != nil {
 			getLogger().Errorf("Error guardando última partida: %v", err)
 		}
 
+		// Historial de rango: anuncia ascensos/descensos de medalla después de la partida
+		b.trackRank(channelID, discordID, accountID, profileStratz)
+
 		time.Sleep(2 * time.Second)
 	}
 
+	// Resúmenes de las sesiones de juego que terminaron
+	b.checkSessions(channelID, users, latest)
+
 	return nil
 }
 
-// RunStatsScheduler ejecuta en bucle y, a la hora STATS_TIME (HH:MM), envía stats de todos los registrados al canal de notificaciones.
-// Si STATS_TIME está vacío, retorna sin hacer nada.
-func (b *Bot) RunStatsScheduler() {
-	if b.config.StatsTime == "" {
-		getLogger().Debug("STATS_TIME vacío, scheduler de stats desactivado")
+// dailySchedule es una hora diaria HH:MM de .env (STATS_TIME, RECAP_TIME). Se relee en cada tick, así que
+// /dota config set la reprograma sin reiniciar; vacía = desactivada.
+type dailySchedule struct {
+	name      string // variable de .env, para los logs
+	value     string
+	enabled   bool
+	hour, min int
+	lastDay   string // fecha (2006-01-02) del último envío
+}
+
+// due indica si a now le toca el envío con la hora value (como mucho una vez al día).
+func (d *dailySchedule) due(value string, now time.Time) bool {
+	if value != d.value {
+		d.reschedule(value)
+	}
+	if !d.enabled || now.Hour() != d.hour || now.Minute() != d.min {
+		return false
+	}
+	today := now.Format("2006-01-02")
+	if d.lastDay == today {
+		return false
+	}
+	d.lastDay = today
+	return true
+}
+
+// reschedule interpreta la nueva hora (se desactiva si está vacía o es inválida)
+func (d *dailySchedule) reschedule(value string) {
+	d.value = value
+	d.enabled = false
+	if value == "" {
+		getLogger().Infof("%s vacío, envío automático desactivado", d.name)
 		return
 	}
-	statsTime, err := time.Parse("15:04", b.config.StatsTime)
+	t, err := time.Parse("15:04", value)
 	if err != nil {
-		getLogger().Warnf("STATS_TIME inválido (%q), usar HH:MM (ej. 20:00): %v", b.config.StatsTime, err)
+		getLogger().Warnf("%s inválido (%q), usar HH:MM (ej. 20:00): %v", d.name, value, err)
 		return
 	}
-	targetHour, targetMin := statsTime.Hour(), statsTime.Minute()
+	d.enabled, d.hour, d.min = true, t.Hour(), t.Minute()
+	getLogger().Infof("%s: envío diario a las %s", d.name, value)
+}
+
+// RunStatsScheduler ejecuta en bucle y, a la hora STATS_TIME (HH:MM), envía stats de todos los registrados al canal
+// de notificaciones; a la hora RECAP_TIME envía el recap semanal los lunes y el mensual el día 1.
+// Ambas horas se releen cada minuto (/dota config set las reprograma sin reiniciar); vacías = sin envío.
+func (b *Bot) RunStatsScheduler() {
 	if b.stratzClient == nil || !b.stratzClient.IsConfigured() {
 		getLogger().Warn("Stats diarios: Stratz no configurado, scheduler desactivado")
 		return
 	}
-	getLogger().Infof("Scheduler de stats diarios a las %s", b.config.StatsTime)
+	stats := &dailySchedule{name: "STATS_TIME"}
+	recaps := &dailySchedule{name: "RECAP_TIME"}
+	stats.reschedule(b.cfg().StatsTime)
+	recaps.reschedule(b.cfg().RecapTime)
 
 	ticker := time.NewTicker(1 * time.Minute)
 	defer ticker.Stop()
 	for range ticker.C {
+		cfg := b.cfg()
 		now := time.Now()
-		if now.Hour() != targetHour || now.Minute() != targetMin {
-			continue
+		if stats.due(cfg.StatsTime, now) {
+			b.sendDailyStats(cfg)
 		}
-		today := now.Format("2006-01-02")
-		b.statsMu.Lock()
-		if b.lastStatsDay == today {
-			b.statsMu.Unlock()
-			continue
+		if recaps.due(cfg.RecapTime, now) {
+			b.sendDueRecaps(now)
 		}
-		b.lastStatsDay = today
-		b.statsMu.Unlock()
+	}
+}
 
-		channelID, errChan := b.userStore.GetChannel()
-		if errChan != nil || channelID == "" {
-			getLogger().Warn("Stats diarios: no hay canal configurado, omitiendo")
+// sendDueRecaps envía los recaps que tocan hoy (semanal los lunes, mensual el día 1) al canal de notificaciones.
+func (b *Bot) sendDueRecaps(now time.Time) {
+	periods := duePeriods(now)
+	if len(periods) == 0 {
+		return
+	}
+	channelID := b.notificationChannel()
+	if channelID == "" {
+		getLogger().Warn("Recap: no hay canal configurado, omitiendo")
+		return
+	}
+	if len(b.userStore.GetAll()) == 0 {
+		getLogger().Debug("Recap: no hay usuarios registrados")
+		return
+	}
+	getLogger().Infof("Enviando recap %s", recapPeriodNames(periods))
+	for _, period := range periods {
+		b.sendRecap(channelID, period, now)
+	}
+}
+
+// sendDailyStats envía las stats por héroe de todos los registrados al canal de notificaciones.
+func (b *Bot) sendDailyStats(cfg *config.Config) {
+	channelID, errChan := b.userStore.GetChannel()
+	if errChan != nil || channelID == "" {
+		getLogger().Warn("Stats diarios: no hay canal configurado, omitiendo")
+		return
+	}
+	users := b.userStore.GetAll()
+	if len(users) == 0 {
+		getLogger().Debug("Stats diarios: no hay usuarios registrados")
+		return
+	}
+	minGames := cfg.StatsMinGames
+	take := cfg.StatsTake
+	lang := b.channelLang(channelID)
+	getLogger().Infof("Enviando stats diarios para %d jugador(es) a las %s", len(users), cfg.StatsTime)
+	for discordID, accountID := range users {
+		accountIDInt, errParse := strconv.ParseInt(accountID, 10, 64)
+		if errParse != nil {
+			getLogger().Debugf("Stats diarios: account_id inválido para %s: %s", discordID, accountID)
 			continue
 		}
-		users := b.userStore.GetAll()
-		if len(users) == 0 {
-			getLogger().Debug("Stats diarios: no hay usuarios registrados")
+		playerName, avatarURL := b.getPlayerNameAndAvatar(accountID, accountIDInt)
+		heroStats, err := b.stratzClient.GetPlayerHeroStats(accountIDInt, minGames, take)
+		if err != nil {
+			getLogger().Errorf("Stats diarios: GetPlayerHeroStats para %s: %v", accountID, err)
 			continue
 		}
-		minGames := b.config.StatsMinGames
-		take := b.config.StatsTake
-		getLogger().Infof("Enviando stats diarios para %d jugador(es) a las %s", len(users), b.config.StatsTime)
-		for discordID, accountID := range users {
-			accountIDInt, errParse := strconv.ParseInt(accountID, 10, 64)
-			if errParse != nil {
-				getLogger().Debugf("Stats diarios: account_id inválido para %s: %s", discordID, accountID)
-				continue
-			}
-			playerName, avatarURL := b.getPlayerNameAndAvatar(accountID, accountIDInt)
-			heroStats, err := b.stratzClient.GetPlayerHeroStats(accountIDInt, minGames, take)
-			if err != nil {
-				getLogger().Errorf("Stats diarios: GetPlayerHeroStats para %s: %v", accountID, err)
-				continue
-			}
-			if len(heroStats) == 0 {
-				getLogger().Debugf("Stats diarios: sin héroes con ≥%d partidas para %s", minGames, accountID)
-				continue
-			}
-			embed := b.buildStatsEmbed(heroStats, minGames, take, playerName, avatarURL)
-			_, errSend := b.session.ChannelMessageSendEmbed(channelID, embed)
-			if errSend != nil {
-				getLogger().Errorf("Stats diarios: error enviando embed para %s: %v", accountID, errSend)
-			}
-			time.Sleep(1 * time.Second) // evitar rate limit
+		if len(heroStats) == 0 {
+			getLogger().Debugf("Stats diarios: sin héroes con ≥%d partidas para %s", minGames, accountID)
+			continue
+		}
+		embed := b.buildStatsEmbed(lang, heroStats, minGames, take, playerName, avatarURL, "")
+		_, errSend := b.session.ChannelMessageSendEmbed(channelID, embed)
+		if errSend != nil {
+			getLogger().Errorf("Stats diarios: error enviando embed para %s: %v", accountID, errSend)
 		}
+		time.Sleep(1 * time.Second) // evitar rate limit
 	}
 }
 
-// formatLaneOutcomeEnum devuelve texto en español para LaneOutcomeEnums de Stratz.
-func formatLaneOutcomeEnum(outcome string) string {
+// formatLaneOutcomeEnum devuelve el texto en lang para LaneOutcomeEnums de Stratz.
+func formatLaneOutcomeEnum(lang i18n.Lang, outcome string) string {
 	switch strings.ToUpper(outcome) {
 	case "RADIANT_VICTORY":
-		return "Victoria Radiant"
+		return i18n.T(lang, "lane.radiant_victory")
 	case "RADIANT_STOMP":
-		return "Stomp Radiant"
+		return i18n.T(lang, "lane.radiant_stomp")
 	case "DIRE_VICTORY":
-		return "Victoria Dire"
+		return i18n.T(lang, "lane.dire_victory")
 	case "DIRE_STOMP":
-		return "Stomp Dire"
+		return i18n.T(lang, "lane.dire_stomp")
 	case "TIE":
-		return "Empate"
+		return i18n.T(lang, "lane.tie")
 	default:
 		if outcome == "" {
 			return "—"
@@ -1142,8 +2000,8 @@ func formatLaneOutcomeEnum(outcome string) string {
 
 // formatLaneOutcomeWithColor devuelve el texto del outcome con emoji de color según si el jugador ganó o perdió esa línea.
 // isRadiant = equipo del jugador. 🟢 = victoria de su equipo en esa línea, 🔴 = derrota, sin emoji = empate.
-func formatLaneOutcomeWithColor(outcome string, isRadiant bool) string {
-	text := formatLaneOutcomeEnum(outcome)
+func formatLaneOutcomeWithColor(lang i18n.Lang, outcome string, isRadiant bool) string {
+	text := formatLaneOutcomeEnum(lang, outcome)
 	upper := strings.ToUpper(outcome)
 	if upper == "TIE" || upper == "" {
 		return text
@@ -1184,11 +2042,11 @@ func playerLanePosition(lane string, isRadiant bool) string {
 
 // buildLaneOutcomeText construye (1) línea de victoria/derrota en fase de línea para el jugador (texto pequeño) y (2) resumen por línea.
 // En el resumen por línea: 🟢 = victoria del equipo del jugador en esa línea, 🔴 = derrota, sin emoji = empate.
-func (b *Bot) buildLaneOutcomeText(match *dota.MatchResponse, player *dota.Player) (lanePhaseLine, laneSummary string) {
+func (b *Bot) buildLaneOutcomeText(lang i18n.Lang, match *dota.MatchResponse, player *dota.Player) (lanePhaseLine, laneSummary string) {
 	isRadiant := player.IsRadiant != nil && *player.IsRadiant
-	topO := formatLaneOutcomeWithColor(match.TopLaneOutcome, isRadiant)
-	midO := formatLaneOutcomeWithColor(match.MidLaneOutcome, isRadiant)
-	botO := formatLaneOutcomeWithColor(match.BottomLaneOutcome, isRadiant)
+	topO := formatLaneOutcomeWithColor(lang, match.TopLaneOutcome, isRadiant)
+	midO := formatLaneOutcomeWithColor(lang, match.MidLaneOutcome, isRadiant)
+	botO := formatLaneOutcomeWithColor(lang, match.BottomLaneOutcome, isRadiant)
 	pos := playerLanePosition(player.Lane, isRadiant)
 
 	// Resumen siempre: Top / Mid / Bottom (marcar (tú) en la línea del jugador)
@@ -1196,11 +2054,11 @@ func (b *Bot) buildLaneOutcomeText(match *dota.MatchResponse, player *dota.Playe
 	midLabel := "Mid"
 	botLabel := "Bottom"
 	if pos == "top" {
-		topLabel = "Top (tú)"
+		topLabel = i18n.T(lang, "lane.you", topLabel)
 	} else if pos == "mid" {
-		midLabel = "Mid (tú)"
+		midLabel = i18n.T(lang, "lane.you", midLabel)
 	} else if pos == "bottom" {
-		botLabel = "Bottom (tú)"
+		botLabel = i18n.T(lang, "lane.you", botLabel)
 	}
 	laneSummary = fmt.Sprintf("%s: %s\n%s: %s\n%s: %s", topLabel, topO, midLabel, midO, botLabel, botO)
 
@@ -1223,18 +2081,18 @@ func (b *Bot) buildLaneOutcomeText(match *dota.MatchResponse, player *dota.Playe
 	var laneResult string
 	switch outcome {
 	case "TIE":
-		laneResult = "*Empate en fase de línea*"
+		laneResult = i18n.T(lang, "lane.phase_tie")
 	case "RADIANT_VICTORY", "RADIANT_STOMP":
 		if isRadiant {
-			laneResult = "*✅ Victoria en fase de línea*"
+			laneResult = i18n.T(lang, "lane.phase_win")
 		} else {
-			laneResult = "*❌ Derrota en fase de línea*"
+			laneResult = i18n.T(lang, "lane.phase_loss")
 		}
 	case "DIRE_VICTORY", "DIRE_STOMP":
 		if isRadiant {
-			laneResult = "*❌ Derrota en fase de línea*"
+			laneResult = i18n.T(lang, "lane.phase_loss")
 		} else {
-			laneResult = "*✅ Victoria en fase de línea*"
+			laneResult = i18n.T(lang, "lane.phase_win")
 		}
 	default:
 		laneResult = ""
@@ -1242,21 +2100,95 @@ func (b *Bot) buildLaneOutcomeText(match *dota.MatchResponse, player *dota.Playe
 	return laneResult, laneSummary
 }
 
-func (b *Bot) sendMatchNotification(channelID string, match *dota.MatchResponse, player *dota.Player, profile *dota.PlayersResponse, accountID string) error {
+// sendMatchNotification publica la partida en channelID con las partidas destacadas ya detectadas;
+// según prefs menciona a discordID y le envía una copia por mensaje directo.
+func (b *Bot) sendMatchNotification(channelID string, match *dota.MatchResponse, player *dota.Player, profile *dota.PlayersResponse, accountID string, highlights []dota.Highlight, discordID string, prefs storage.NotifyPrefs) error {
+	lang := b.channelLang(channelID)
+
+	// Plantilla del servidor (/dota template); si no tiene o falla al renderizar, embed por defecto
+	var embed *discordgo.MessageEmbed
+	attachments := true
+	if tpl, ok := b.guildTemplate(channelID); ok {
+		rendered, err := renderTemplate(lang, tpl, b.buildMatchView(lang, match, player, profile, accountID, highlights))
+		if err != nil {
+			getLogger().Warnf("Plantilla de notificación inválida para la partida %d, usando el formato por defecto: %v", match.MatchID, err)
+		} else {
+			embed = rendered
+			attachments = templateAttachments(tpl)
+		}
+	}
+	if embed == nil {
+		embed = b.buildMatchEmbed(lang, match, player, profile, accountID)
+	}
+
+	// Partidas destacadas: línea extra, color dorado y mención opcional al rol HIGHLIGHT_ROLE_ID
+	applyHighlights(lang, embed, highlights)
+	embeds := []*discordgo.MessageEmbed{embed}
+	var files []*discordgo.File
+	if attachments {
+		embeds, files = b.matchAttachments(lang, embed, match, player.IsRadiant == nil || *player.IsRadiant, i18n.T(lang, "match.your_team"))
+	}
+	message := &discordgo.MessageSend{Embeds: embeds, Files: files}
+	var mentions []string
+	allowed := &discordgo.MessageAllowedMentions{}
+	if prefs.Mention {
+		mentions = append(mentions, fmt.Sprintf("<@%s>", discordID))
+		allowed.Users = []string{discordID}
+	}
+	if len(highlights) > 0 && b.cfg().HighlightRoleID != "" {
+		mentions = append(mentions, fmt.Sprintf("<@&%s>", b.cfg().HighlightRoleID))
+		allowed.Roles = []string{b.cfg().HighlightRoleID}
+	}
+	if len(mentions) > 0 {
+		message.Content = strings.Join(mentions, " ")
+		message.AllowedMentions = allowed
+	}
+
+	var dmFiles []*discordgo.File
+	if prefs.DM {
+		dmFiles = copyFiles(files)
+	}
+	if _, err := b.session.ChannelMessageSendComplex(channelID, message); err != nil {
+		return err
+	}
+	if prefs.DM {
+		if err := b.sendDirectCopy(discordID, embeds, dmFiles); err != nil {
+			getLogger().Warnf("No se pudo enviar la copia por MD de la partida %d a %s: %v", match.MatchID, discordID, err)
+		}
+	}
+	return nil
+}
+
+// matchFooter arma el footer de la notificación: racha (desde Stratz), Match ID, aviso si la partida aún no está
+// parseada y, en otra línea, cómo se puntúa el MVP.
+func matchFooter(lang i18n.Lang, match *dota.MatchResponse, streakText string) string {
+	var parts []string
+	if streakText != "" {
+		parts = append(parts, streakText)
+	}
+	parts = append(parts, fmt.Sprintf("Match ID: %d", match.MatchID))
+	if !match.Parsed {
+		parts = append(parts, i18n.T(lang, "match.unparsed"))
+	}
+	return strings.Join(parts, " | ") + "\n" + dota.ScoringDescription(lang)
+}
+
+// buildMatchEmbed construye el embed de la partida desde la perspectiva de player (usado por notificaciones y /dota match) en lang.
+func (b *Bot) buildMatchEmbed(lang i18n.Lang, match *dota.MatchResponse, player *dota.Player, profile *dota.PlayersResponse, accountID string) *discordgo.MessageEmbed {
 	// Determinar resultado (RadiantWin + IsRadiant)
 	isWin := false
 	if match.RadiantWin != nil && player.IsRadiant != nil {
 		isWin = *match.RadiantWin == *player.IsRadiant
 	}
-	resultText := "❌ Derrota"
+	resultText := i18n.T(lang, "match.loss")
 	resultColor := 0xe74c3c // Rojo
 	if isWin {
-		resultText = "✅ Victoria"
+		resultText = i18n.T(lang, "match.win")
 		resultColor = 0x2ecc71 // Verde
 	}
 
 	// Obtener nombre del jugador
-	personaname := "Jugador"
+	personaname := i18n.T(lang, "player.default")
 	avatarURL := ""
 	if profile != nil {
 		if profile.Profile.Personaname != "" {
@@ -1281,36 +2213,17 @@ func (b *Bot) sendMatchNotification(channelID string, match *dota.MatchResponse,
 	gameModeName := b.dotaClient.GetGameModeName(match.GameMode)
 	gameModeDisplayName := dota.GameModeDisplayName(gameModeName)
 
-	if b.config.Debug {
+	if b.cfg().Debug {
 		getLogger().Debugf("Match %d: RadiantScore=%d DireScore=%d GameMode=%d gameModeName=%q",
 			match.MatchID, match.RadiantScore, match.DireScore, match.GameMode, gameModeName)
 	}
 
-	// W/L del héroe desde Stratz
-	heroRecordText := "N/A"
-	if b.stratzClient != nil && b.stratzClient.IsConfigured() {
-		accountIDInt, _ := strconv.ParseInt(accountID, 10, 64)
-		heroWL, err := b.stratzClient.GetPlayerWinLoss(accountIDInt, 20, player.HeroID)
-		if err != nil {
-			getLogger().Warnf("No se pudo obtener W/L del héroe %s para account_id %s: %v", heroName, accountID, err)
-		} else if heroWL != nil {
-			total := heroWL.Win + heroWL.Lose
-			if total > 0 {
-				winRate := float64(heroWL.Win) / float64(total) * 100
-				heroRecordText = fmt.Sprintf("%d-%d (%.1f%%)", heroWL.Win, heroWL.Lose, winRate)
-			}
-		}
-	}
-
-	// Racha desde Stratz (para footer más abajo)
-	var recentStratzMatches []dota.StratzMatch
-	if b.stratzClient != nil && b.stratzClient.IsConfigured() {
-		accountIDInt, _ := strconv.ParseInt(accountID, 10, 64)
-		recentStratzMatches, _ = b.stratzClient.GetPlayerRecentMatches(accountIDInt, 10)
-	}
+	// W/L del héroe y racha desde Stratz (racha para el footer más abajo)
+	heroRecordText := b.heroRecordText(accountID, player.HeroID)
+	streakText := b.streakText(lang, accountID)
 
 	// Lane outcome: resumen por línea y victoria/derrota en fase de línea (si jugó una línea; jungle/roaming no se marca)
-	lanePhaseLine, laneSummary := b.buildLaneOutcomeText(match, player)
+	lanePhaseLine, laneSummary := b.buildLaneOutcomeText(lang, match, player)
 
 	// Título: nombre [RANGO] - Victoria/Derrota (rango solo si está disponible)
 	title := fmt.Sprintf("%s - %s", personaname, resultText)
@@ -1338,12 +2251,12 @@ func (b *Bot) sendMatchNotification(channelID string, match *dota.MatchResponse,
 				Inline: true,
 			},
 			{
-				Name:   "Duración",
+				Name:   i18n.T(lang, "match.duration"),
 				Value:  dota.FormatDuration(match.Duration),
 				Inline: true,
 			},
 			{
-				Name:   "Nivel",
+				Name:   i18n.T(lang, "match.level"),
 				Value:  strconv.Itoa(player.Level),
 				Inline: true,
 			},
@@ -1358,20 +2271,18 @@ func (b *Bot) sendMatchNotification(channelID string, match *dota.MatchResponse,
 				Inline: true,
 			},
 			{
-				Name:   "Modo",
+				Name:   i18n.T(lang, "match.mode"),
 				Value:  gameModeDisplayName,
 				Inline: true,
 			},
 			{
-				Name:   fmt.Sprintf("Record con %s (últ. 20)", heroName),
+				Name:   i18n.T(lang, "match.hero_record", heroName),
 				Value:  heroRecordText,
 				Inline: false,
 			},
 		},
-		Footer: &discordgo.MessageEmbedFooter{
-			Text: fmt.Sprintf("Match ID: %d", match.MatchID),
-		},
-		URL: fmt.Sprintf("https://stratz.com/matches/%d", match.MatchID),
+		Footer: &discordgo.MessageEmbedFooter{},
+		URL:    fmt.Sprintf("https://stratz.com/matches/%d", match.MatchID),
 	}
 	if avatarURL != "" {
 		embed.Author = &discordgo.MessageEmbedAuthor{
@@ -1392,7 +2303,7 @@ func (b *Bot) sendMatchNotification(channelID string, match *dota.MatchResponse,
 			}
 		}
 		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
-			Name:   "Lane / Rol",
+			Name:   i18n.T(lang, "match.lane_role"),
 			Value:  laneText,
 			Inline: true,
 		})
@@ -1401,7 +2312,7 @@ func (b *Bot) sendMatchNotification(channelID string, match *dota.MatchResponse,
 	// Resultado por línea (Stratz lane outcomes)
 	if laneSummary != "" {
 		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
-			Name:   "Resultado por línea",
+			Name:   i18n.T(lang, "match.lane_results"),
 			Value:  laneSummary,
 			Inline: false,
 		})
@@ -1434,6 +2345,14 @@ func (b *Bot) sendMatchNotification(channelID string, match *dota.MatchResponse,
 		embed.Fields = append(embed.Fields, damageFields...)
 	}
 
+	// Build final y timings de items grandes (timings solo en partidas parseadas)
+	if itemsField := b.buildItemsField(lang, player); itemsField != nil {
+		embed.Fields = append(embed.Fields, itemsField)
+	}
+
+	// MVP y peor actuación de los 10 jugadores (ver dota.ScorePlayers)
+	embed.Fields = append(embed.Fields, b.buildMVPFields(lang, match)...)
+
 	// Agregar rank si está disponible
 	if profile != nil && profile.RankTier != nil {
 		rankName := dota.GetRankName(profile.RankTier)
@@ -1464,8 +2383,8 @@ func (b *Bot) sendMatchNotification(channelID string, match *dota.MatchResponse,
 
 	getLogger().Debugf("Verificando %d jugadores con AccountID != 0", len(playersToCheck))
 
-	// Solo Stratz para W/L de jugadores
-	if b.stratzClient != nil && b.stratzClient.IsConfigured() {
+	// Solo Stratz para W/L de jugadores; con miniaturas locales la lista se sustituye por el scoreboard PNG adjunto
+	if !scoreboardAvailable() && b.stratzClient != nil && b.stratzClient.IsConfigured() {
 		var playerIDs []int64
 		for _, p := range playersToCheck {
 			playerIDs = append(playerIDs, int64(p.AccountID))
@@ -1488,7 +2407,7 @@ func (b *Bot) sendMatchNotification(channelID string, match *dota.MatchResponse,
 				heroName := b.dotaClient.GetHeroName(p.HeroID)
 				playerName := p.Personaname
 				if playerName == "" {
-					playerName = fmt.Sprintf("Jugador %d", p.AccountID)
+					playerName = i18n.T(lang, "scoreboard.player_id", p.AccountID)
 				}
 
 				getLogger().Debugf("  ✅ Stratz: AccountID %d, Nombre: '%s', Héroe: '%s', W/L: %d/%d",
@@ -1557,7 +2476,7 @@ func (b *Bot) sendMatchNotification(channelID string, match *dota.MatchResponse,
 				}
 				line := fmt.Sprintf("%s | [%s](%s) | W/L: %s\n", vp.HeroName, vp.PlayerName, stratzURL, wlText)
 				if playersList.Len()+len(line) > maxFieldLength {
-					playersList.WriteString("... y más")
+					playersList.WriteString(i18n.T(lang, "match.and_more"))
 					return false
 				}
 				playersList.WriteString(line)
@@ -1568,20 +2487,100 @@ func (b *Bot) sendMatchNotification(channelID string, match *dota.MatchResponse,
 		addPlayersToList(direPlayers, "🌙 Dire")
 		if playersList.Len() > 0 {
 			embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
-				Name:   "👥 Jugadores (Perfiles Públicos)",
+				Name:   i18n.T(lang, "match.players"),
 				Value:  playersList.String(),
 				Inline: false,
 			})
 		}
 	}
 
-	// Agregar racha en el footer (desde Stratz)
-	if len(recentStratzMatches) > 0 {
-		accountIDInt, _ := strconv.ParseInt(accountID, 10, 64)
-		streak := dota.AnalyzeStreakFromStratzMatches(recentStratzMatches, accountIDInt)
-		embed.Footer.Text = fmt.Sprintf("%s | Match ID: %d", streak.CurrentStreak, match.MatchID)
+	embed.Footer.Text = matchFooter(lang, match, streakText)
+
+	return embed
+}
+
+// heroRecordText devuelve el W/L del jugador con el héroe en sus últimas 20 partidas ("12-8 (60.0%)"; "N/A" sin datos).
+func (b *Bot) heroRecordText(accountID string, heroID int) string {
+	if b.stratzClient == nil || !b.stratzClient.IsConfigured() {
+		return "N/A"
 	}
+	accountIDInt, _ := strconv.ParseInt(accountID, 10, 64)
+	// En el poller, las partidas ya pedidas para las reglas de destacada suelen incluir las últimas 20 con el héroe
+	if recent, ok := b.cachedRecentMatches(accountIDInt, historyMaxMatches); ok {
+		if win, lose, ok := heroRecordFromMatches(recent, accountIDInt, heroID, heroRecordMatches); ok {
+			return formatHeroRecord(win, lose)
+		}
+	}
+	heroWL, err := b.stratzClient.GetPlayerWinLoss(accountIDInt, heroRecordMatches, heroID)
+	if err != nil {
+		getLogger().Warnf("No se pudo obtener W/L del héroe %s para account_id %s: %v", b.dotaClient.GetHeroName(heroID), accountID, err)
+		return "N/A"
+	}
+	if heroWL == nil || heroWL.Win+heroWL.Lose == 0 {
+		return "N/A"
+	}
+	return formatHeroRecord(heroWL.Win, heroWL.Lose)
+}
+
+// heroRecordMatches es cuántas partidas con el héroe cuenta el W/L de la notificación
+const heroRecordMatches = 20
+
+// heroRecordFromMatches cuenta el W/L con el héroe en las últimas take partidas con él dentro de matches.
+// ok=false si matches no llega a take partidas con el héroe y puede haber más antiguas (hay que pedirlas a Stratz).
+func heroRecordFromMatches(matches []dota.StratzMatch, accountID int64, heroID, take int) (win, lose int, ok bool) {
+	for i := range matches {
+		p := dota.FindStratzPlayer(&matches[i], accountID)
+		if p == nil || p.HeroID != heroID {
+			continue
+		}
+		if dota.IsStratzPlayerWin(&matches[i], p) {
+			win++
+		} else {
+			lose++
+		}
+		if win+lose == take {
+			return win, lose, true
+		}
+	}
+	return win, lose, len(matches) < historyMaxMatches
+}
 
-	_, err := b.session.ChannelMessageSendEmbed(channelID, embed)
-	return err
+func formatHeroRecord(win, lose int) string {
+	if win+lose == 0 {
+		return "N/A"
+	}
+	return fmt.Sprintf("%d-%d (%.1f%%)", win, lose, float64(win)/float64(win+lose)*100)
+}
+
+// recentStreak devuelve la racha actual del jugador según sus últimas 10 partidas (false si Stratz no devuelve partidas).
+func (b *Bot) recentStreak(accountID string) (dota.StreakResult, bool) {
+	if b.stratzClient == nil || !b.stratzClient.IsConfigured() {
+		return dota.StreakResult{}, false
+	}
+	accountIDInt, _ := strconv.ParseInt(accountID, 10, 64)
+	recent, _ := b.recentMatches(accountIDInt, 10)
+	if len(recent) == 0 {
+		return dota.StreakResult{}, false
+	}
+	return dota.AnalyzeStreakFromStratzMatches(recent, accountIDInt), true
+}
+
+// streakText devuelve la racha actual en lang ("3 victorias consecutivas 🔥"); vacío si no hay partidas.
+func (b *Bot) streakText(lang i18n.Lang, accountID string) string {
+	streak, ok := b.recentStreak(accountID)
+	if !ok {
+		return ""
+	}
+	return formatStreak(lang, streak)
+}
+
+// formatStreak devuelve la racha en lang; "Sin partidas" si no hay ninguna.
+func formatStreak(lang i18n.Lang, streak dota.StreakResult) string {
+	if streak.StreakCount == 0 {
+		return i18n.T(lang, "common.no_matches")
+	}
+	if streak.IsWinStreak {
+		return i18n.T(lang, "match.streak_win", streak.StreakCount)
+	}
+	return i18n.T(lang, "match.streak_loss", streak.StreakCount)
 }
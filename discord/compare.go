@@ -0,0 +1,192 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// handleCompareSlash compara dos jugadores registrados: /dota compare a:@x b:@y.
+// Usa las últimas STATS_TAKE partidas de cada uno y cruza por match ID para el récord juntos/en contra.
+func (b *Bot) handleCompareSlash(s *discordgo.Session, i *discordgo.InteractionCreate, subcommand *discordgo.ApplicationCommandInteractionDataOption) {
+	var userA, userB *discordgo.User
+	for _, option := range subcommand.Options {
+		switch option.Name {
+		case "a":
+			userA = option.UserValue(s)
+		case "b":
+			userB = option.UserValue(s)
+		}
+	}
+	if userA == nil || userB == nil {
+		b.sendFollowup(s, i, b.t(i, "compare.usage"))
+		return
+	}
+	if userA.ID == userB.ID {
+		b.sendFollowup(s, i, b.t(i, "compare.same_user"))
+		return
+	}
+
+	if b.stratzClient == nil || !b.stratzClient.IsConfigured() {
+		b.sendFollowup(s, i, b.t(i, "err.stratz_not_configured"))
+		return
+	}
+
+	lang := b.lang(i)
+	accountA, errMsg := b.registeredAccount(lang, userA)
+	if errMsg != "" {
+		b.sendFollowup(s, i, errMsg)
+		return
+	}
+	accountB, errMsg := b.registeredAccount(lang, userB)
+	if errMsg != "" {
+		b.sendFollowup(s, i, errMsg)
+		return
+	}
+
+	take := b.cfg().StatsTake
+	matchesA, err := b.stratzClient.GetPlayerRecentMatches(accountA, take)
+	if err != nil {
+		getLogger().Errorf("compare: GetPlayerRecentMatches para %d: %v", accountA, err)
+		b.sendFollowup(s, i, i18n.T(lang, "compare.matches_error", userA.Username, err))
+		return
+	}
+	matchesB, err := b.stratzClient.GetPlayerRecentMatches(accountB, take)
+	if err != nil {
+		getLogger().Errorf("compare: GetPlayerRecentMatches para %d: %v", accountB, err)
+		b.sendFollowup(s, i, i18n.T(lang, "compare.matches_error", userB.Username, err))
+		return
+	}
+
+	summaryA := dota.SummarizePlayerMatches(matchesA, accountA)
+	summaryB := dota.SummarizePlayerMatches(matchesB, accountB)
+	h2h := dota.HeadToHead(matchesA, matchesB, accountA, accountB)
+
+	b.sendFollowupEmbed(s, i, b.buildCompareEmbed(lang, userA.Username, userB.Username, summaryA, summaryB, h2h, take))
+}
+
+// registeredAccount devuelve el account_id de un usuario registrado, o un mensaje de error para el usuario en lang.
+func (b *Bot) registeredAccount(lang i18n.Lang, user *discordgo.User) (int64, string) {
+	accountID, ok := b.userStore.Get(user.ID)
+	if !ok {
+		return 0, i18n.T(lang, "err.not_registered", user.Username, user.Username)
+	}
+	accountIDInt, err := strconv.ParseInt(accountID, 10, 64)
+	if err != nil {
+		return 0, i18n.T(lang, "err.invalid_account_for", user.Username)
+	}
+	return accountIDInt, ""
+}
+
+// buildCompareEmbed construye el embed lado a lado con el veredicto por métrica.
+func (b *Bot) buildCompareEmbed(lang i18n.Lang, nameA, nameB string, a, bb dota.PlayerMatchSummary, h2h dota.HeadToHeadResult, take int) *discordgo.MessageEmbed {
+	type metric struct {
+		label  string
+		valueA float64
+		valueB float64
+	}
+	metrics := []metric{
+		{i18n.T(lang, "compare.winrate"), a.WinRate(), bb.WinRate()},
+		{"KDA", a.KDA(), bb.KDA()},
+		{"GPM", a.AvgGPM(), bb.AvgGPM()},
+		{"XPM", a.AvgXPM(), bb.AvgXPM()},
+		{i18n.T(lang, "compare.hero_damage"), a.AvgHeroDamage(), bb.AvgHeroDamage()},
+		{i18n.T(lang, "compare.lane"), a.LaneWinRate(), bb.LaneWinRate()},
+	}
+	var verdict []string
+	pointsA, pointsB := 0, 0
+	for _, m := range metrics {
+		switch {
+		case m.valueA > m.valueB:
+			pointsA++
+			verdict = append(verdict, fmt.Sprintf("%s: **%s**", m.label, nameA))
+		case m.valueB > m.valueA:
+			pointsB++
+			verdict = append(verdict, fmt.Sprintf("%s: **%s**", m.label, nameB))
+		default:
+			verdict = append(verdict, i18n.T(lang, "compare.metric_tie", m.label))
+		}
+	}
+	switch {
+	case pointsA > pointsB:
+		verdict = append(verdict, "\n"+i18n.T(lang, "compare.winner", nameA, pointsA, pointsB))
+	case pointsB > pointsA:
+		verdict = append(verdict, "\n"+i18n.T(lang, "compare.winner", nameB, pointsB, pointsA))
+	default:
+		verdict = append(verdict, "\n"+i18n.T(lang, "compare.tie", pointsA, pointsB))
+	}
+
+	h2hText := i18n.T(lang, "compare.no_common")
+	if h2h.TogetherGames+h2h.AgainstGames > 0 {
+		var lines []string
+		if h2h.TogetherGames > 0 {
+			lines = append(lines, i18n.T(lang, "compare.together", h2h.TogetherWins, h2h.TogetherGames-h2h.TogetherWins,
+				100*float64(h2h.TogetherWins)/float64(h2h.TogetherGames)))
+		}
+		if h2h.AgainstGames > 0 {
+			lines = append(lines, i18n.T(lang, "compare.against", nameA, h2h.AgainstWinsA, h2h.AgainstGames-h2h.AgainstWinsA, nameB))
+		}
+		h2hText = strings.Join(lines, "\n")
+	}
+
+	return &discordgo.MessageEmbed{
+		Title: fmt.Sprintf("⚔️ %s vs %s", nameA, nameB),
+		Color: 0x9b59b6,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   nameA,
+				Value:  b.formatCompareColumn(lang, a),
+				Inline: true,
+			},
+			{
+				Name:   nameB,
+				Value:  b.formatCompareColumn(lang, bb),
+				Inline: true,
+			},
+			{
+				Name:   i18n.T(lang, "compare.h2h"),
+				Value:  h2hText,
+				Inline: false,
+			},
+			{
+				Name:   i18n.T(lang, "compare.verdict"),
+				Value:  strings.Join(verdict, "\n"),
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: i18n.T(lang, "compare.footer", take),
+		},
+	}
+}
+
+// formatCompareColumn devuelve el bloque de estadísticas de un jugador para una columna de /dota compare.
+func (b *Bot) formatCompareColumn(lang i18n.Lang, s dota.PlayerMatchSummary) string {
+	if s.Games == 0 {
+		return i18n.T(lang, "common.no_matches")
+	}
+	var favorites []string
+	for _, h := range s.TopHeroes(3) {
+		favorites = append(favorites, fmt.Sprintf("%s (%d)", b.dotaClient.GetHeroName(h.HeroID), h.Games))
+	}
+	favoritesText := "—"
+	if len(favorites) > 0 {
+		favoritesText = strings.Join(favorites, ", ")
+	}
+	laneText := "—"
+	if s.LaneGames > 0 {
+		laneText = fmt.Sprintf("%.0f%% (%d/%d)", s.LaneWinRate(), s.LaneWins, s.LaneGames)
+	}
+	return strings.Join([]string{
+		i18n.T(lang, "compare.col_games", s.Games, s.Wins, s.Losses(), s.WinRate()),
+		fmt.Sprintf("**K/D/A:** %.1f/%.1f/%.1f (%.2f KDA)", s.AvgKills(), s.AvgDeaths(), s.AvgAssists(), s.KDA()),
+		fmt.Sprintf("**GPM/XPM:** %.0f / %.0f", s.AvgGPM(), s.AvgXPM()),
+		i18n.T(lang, "compare.col_damage", formatThousands(int(s.AvgHeroDamage()))),
+		i18n.T(lang, "compare.col_lane", laneText),
+		i18n.T(lang, "compare.col_favorites", favoritesText),
+	}, "\n")
+}
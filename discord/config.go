@@ -0,0 +1,190 @@
+package discord
+
+import (
+	"dota-discord-bot/config"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// configKeyChoices son las opciones de clave de /dota config get|set (las variables de config.RuntimeKeys)
+func configKeyChoices() []*discordgo.ApplicationCommandOptionChoice {
+	choices := make([]*discordgo.ApplicationCommandOptionChoice, 0, len(config.RuntimeKeys))
+	for _, key := range config.RuntimeKeys {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: key, Value: key})
+	}
+	return choices
+}
+
+// cfg devuelve la configuración vigente. No se modifica: /dota config set guarda una copia nueva.
+func (b *Bot) cfg() *config.Config {
+	return b.config.Load()
+}
+
+// RefreshRate devuelve el intervalo de verificación de partidas vigente (REFRESH_RATE, en minutos)
+func (b *Bot) RefreshRate() int {
+	return b.cfg().RefreshRateMinutes
+}
+
+// RefreshRateChanges avisa del nuevo REFRESH_RATE cuando se cambia con /dota config set, para reiniciar el ticker.
+func (b *Bot) RefreshRateChanges() <-chan int {
+	return b.refreshRate
+}
+
+// setConfig valida value y lo aplica a la variable key sobre una copia de la configuración vigente.
+// Reintenta si otro cambio se aplicó entre medias, para no perder ninguno.
+func (b *Bot) setConfig(key, value string) error {
+	for {
+		current := b.cfg()
+		updated := *current
+		if err := updated.Set(key, value); err != nil {
+			return err
+		}
+		if !b.config.CompareAndSwap(current, &updated) {
+			continue
+		}
+		if updated.RefreshRateMinutes != current.RefreshRateMinutes {
+			b.notifyRefreshRate()
+		}
+		return nil
+	}
+}
+
+// notifyRefreshRate avisa a main del REFRESH_RATE vigente. Solo importa el último valor: se descarta el aviso
+// pendiente que main no haya leído.
+func (b *Bot) notifyRefreshRate() {
+	b.refreshMu.Lock()
+	defer b.refreshMu.Unlock()
+	select {
+	case <-b.refreshRate:
+	default:
+	}
+	b.refreshRate <- b.RefreshRate()
+}
+
+// homeGuild es el servidor que administra la configuración: SERVER_ID o, sin él, el del canal de notificaciones.
+// La configuración es una sola para todo el bot (un poller y un canal), así que solo se cambia desde ese servidor.
+func (b *Bot) homeGuild() string {
+	if guildID := b.cfg().ServerID; guildID != "" {
+		return guildID
+	}
+	channelID := b.notificationChannel()
+	if channelID == "" {
+		return ""
+	}
+	if channel, err := b.session.State.Channel(channelID); err == nil {
+		return channel.GuildID
+	}
+	channel, err := b.session.Channel(channelID)
+	if err != nil {
+		getLogger().Warnf("No se pudo obtener el servidor del canal %s: %v", channelID, err)
+		return ""
+	}
+	return channel.GuildID
+}
+
+// applyGuildSettings aplica al arrancar las variables guardadas con /dota config en el servidor principal.
+// Se llama antes de que main cree el ticker, que ya arranca con el REFRESH_RATE guardado.
+func (b *Bot) applyGuildSettings() {
+	guildID := b.homeGuild()
+	if guildID == "" {
+		getLogger().Debug("Sin servidor principal (SERVER_ID ni canal de notificaciones): configuración de .env")
+		return
+	}
+	for key, value := range b.userStore.GetGuildSettings(guildID) {
+		if err := b.setConfig(key, value); err != nil {
+			getLogger().Warnf("Configuración guardada ignorada, %s=%q: %v", key, value, err)
+			continue
+		}
+		getLogger().Infof("Configuración guardada aplicada: %s=%s", key, value)
+	}
+	select {
+	case <-b.refreshRate:
+	default:
+	}
+}
+
+// handleConfigSlash muestra o cambia la configuración en caliente: /dota config get [clave] | set clave:<variable> valor:<valor>.
+// Requiere Gestionar servidor; set solo desde el servidor principal (homeGuild), donde se guarda el cambio antes de aplicarlo.
+func (b *Bot) handleConfigSlash(s *discordgo.Session, i *discordgo.InteractionCreate, group *discordgo.ApplicationCommandInteractionDataOption) {
+	if len(group.Options) == 0 {
+		b.sendFollowup(s, i, b.t(i, "err.invalid_command"))
+		return
+	}
+	if i.GuildID == "" {
+		b.sendFollowup(s, i, b.t(i, "config.guild_only"))
+		return
+	}
+	if !hasManageServer(i) {
+		b.sendFollowup(s, i, b.t(i, "err.need_manage"))
+		return
+	}
+	action := group.Options[0]
+	home := b.homeGuild()
+	if action.Name == "set" && i.GuildID != home {
+		b.sendFollowup(s, i, b.t(i, "config.not_home"))
+		return
+	}
+	var key, value string
+	for _, option := range action.Options {
+		switch option.Name {
+		case "clave":
+			key = option.StringValue()
+		case "valor":
+			value = option.StringValue()
+		}
+	}
+
+	switch action.Name {
+	case "get":
+		keys := config.RuntimeKeys
+		if key != "" {
+			keys = []string{key}
+		}
+		saved := b.userStore.GetGuildSettings(home)
+		lines := []string{b.t(i, "config.current")}
+		for _, k := range keys {
+			current, err := b.cfg().Get(k)
+			if err != nil {
+				b.sendFollowup(s, i, b.t(i, "config.invalid", k, err))
+				return
+			}
+			if current == "" {
+				current = "off"
+			}
+			source := b.t(i, "config.source_env")
+			if _, ok := saved[k]; ok {
+				source = b.t(i, "config.source_saved")
+			}
+			lines = append(lines, fmt.Sprintf("• `%s` = **%s** (%s)", k, current, source))
+		}
+		b.sendFollowup(s, i, strings.Join(lines, "\n"))
+	case "set":
+		// Validar y normalizar sobre una copia: se guarda antes de aplicar, así un error al guardar no deja
+		// un cambio activo que se perdería al reiniciar
+		check := *b.cfg()
+		if err := check.Set(key, value); err != nil {
+			b.sendFollowup(s, i, b.t(i, "config.invalid", key, err))
+			return
+		}
+		current, _ := check.Get(key)
+		if err := b.userStore.SetGuildSetting(i.GuildID, key, current); err != nil {
+			getLogger().Errorf("Error guardando configuración %s: %v", key, err)
+			b.sendFollowup(s, i, b.t(i, "config.save_error"))
+			return
+		}
+		if err := b.setConfig(key, current); err != nil {
+			getLogger().Errorf("Error aplicando configuración %s=%s: %v", key, current, err)
+			b.sendFollowup(s, i, b.t(i, "config.invalid", key, err))
+			return
+		}
+		if current == "" {
+			current = "off"
+		}
+		getLogger().Infof("Configuración cambiada en %s: %s=%s", i.GuildID, key, current)
+		b.sendFollowup(s, i, b.t(i, "config.saved", key, current))
+	default:
+		b.sendFollowup(s, i, b.t(i, "err.unknown_command"))
+	}
+}
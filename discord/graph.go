@@ -0,0 +1,44 @@
+package discord
+
+import (
+	"bytes"
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"dota-discord-bot/render"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// advantageGraphFile es el nombre del adjunto con la gráfica de ventaja (referenciado como attachment://).
+const advantageGraphFile = "ventaja.png"
+
+// attachAdvantageGraph genera la gráfica de ventaja de oro/XP desde el equipo indicado y la pone como imagen del embed
+// (sustituye al render del héroe). upLabel describe qué equipo va arriba (tu equipo, Radiant); la leyenda va en lang.
+// Devuelve los archivos a adjuntar (vacío si la partida no tiene datos de ventaja).
+func attachAdvantageGraph(lang i18n.Lang, embed *discordgo.MessageEmbed, match *dota.MatchResponse, radiant bool, upLabel string) []*discordgo.File {
+	if match == nil || len(match.RadiantGoldAdv) < 2 {
+		return nil
+	}
+	data, err := render.AdvantageGraph(match.RadiantGoldAdv, match.RadiantXPAdv, radiant)
+	if err != nil {
+		getLogger().Warnf("Gráfica de ventaja para partida %d: %v", match.MatchID, err)
+		return nil
+	}
+	embed.Image = &discordgo.MessageEmbedImage{URL: "attachment://" + advantageGraphFile}
+	embed.Description += "\n" + i18n.T(lang, "graph.legend", upLabel)
+	return []*discordgo.File{{
+		Name:        advantageGraphFile,
+		ContentType: "image/png",
+		Reader:      bytes.NewReader(data),
+	}}
+}
+
+// matchPlayerIsRadiant indica si accountID jugó en Radiant (true si no se encuentra).
+func matchPlayerIsRadiant(match *dota.MatchResponse, accountID int64) bool {
+	for _, p := range match.Players {
+		if int64(p.AccountID) == accountID && p.IsRadiant != nil {
+			return *p.IsRadiant
+		}
+	}
+	return true
+}
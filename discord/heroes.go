@@ -0,0 +1,77 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// autocompleteMaxChoices es el máximo de sugerencias que acepta Discord por respuesta.
+const autocompleteMaxChoices = 25
+
+// handleAutocomplete responde con sugerencias para la opción que se está escribiendo (por ahora solo heroe).
+func (b *Bot) handleAutocomplete(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	data := i.ApplicationCommandData()
+	if data.Name != "dota" || len(data.Options) == 0 {
+		return
+	}
+	focused := focusedOption(data.Options[0].Options)
+	if focused == nil {
+		return
+	}
+
+	var choices []*discordgo.ApplicationCommandOptionChoice
+	switch focused.Name {
+	case "heroe":
+		for _, h := range b.dotaClient.Constants().SearchHeroes(focused.StringValue(), autocompleteMaxChoices) {
+			choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: h.Name, Value: h.Name})
+		}
+	}
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionApplicationCommandAutocompleteResult,
+		Data: &discordgo.InteractionResponseData{Choices: choices},
+	})
+	if err != nil {
+		getLogger().Debugf("Error respondiendo autocompletado de %s: %v", focused.Name, err)
+	}
+}
+
+// focusedOption devuelve la opción que el usuario está escribiendo (nil si ninguna)
+func focusedOption(options []*discordgo.ApplicationCommandInteractionDataOption) *discordgo.ApplicationCommandInteractionDataOption {
+	for _, option := range options {
+		if option.Focused {
+			return option
+		}
+	}
+	return nil
+}
+
+// formatHeroInfo devuelve "Agilidad · Melee · Carry, Escape, Nuker · Complejidad ★☆☆ · Apodos: am, magina" en lang.
+func formatHeroInfo(lang i18n.Lang, h dota.HeroInfo) string {
+	parts := []string{heroAttributeName(lang, h.PrimaryAttr)}
+	if h.AttackType != "" {
+		parts = append(parts, h.AttackType)
+	}
+	if len(h.Roles) > 0 {
+		parts = append(parts, strings.Join(h.Roles, ", "))
+	}
+	if h.Complexity > 0 {
+		parts = append(parts, i18n.T(lang, "hero.complexity", strings.Repeat("★", h.Complexity)+strings.Repeat("☆", 3-h.Complexity)))
+	}
+	if len(h.Aliases) > 0 {
+		parts = append(parts, i18n.T(lang, "hero.aliases", strings.Join(h.Aliases, ", ")))
+	}
+	return strings.Join(parts, " · ")
+}
+
+// heroAttributeName devuelve el nombre del atributo principal en lang (str → Fuerza/Strength, agi, int, all)
+func heroAttributeName(lang i18n.Lang, attr string) string {
+	switch attr {
+	case "str", "agi", "int", "all":
+		return i18n.T(lang, "attr."+attr)
+	}
+	return i18n.T(lang, "attr.unknown")
+}
@@ -0,0 +1,41 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// highlightColor es el color del embed de una partida destacada.
+const highlightColor = 0xf1c40f
+
+// detectMatchHighlights evalúa las reglas de partida destacada con el historial reciente del jugador.
+// En el poller el historial queda en caché y lo reutilizan la racha y el W/L con el héroe de la notificación.
+func (b *Bot) detectMatchHighlights(match *dota.MatchResponse, player *dota.Player, accountID string) []dota.Highlight {
+	accountIDInt, _ := strconv.ParseInt(accountID, 10, 64)
+	ctx := dota.HighlightContext{SteamAccountID: accountIDInt}
+	if b.stratzClient != nil && b.stratzClient.IsConfigured() {
+		recent, err := b.recentMatches(accountIDInt, historyMaxMatches)
+		if err != nil {
+			getLogger().Warnf("Highlights: GetPlayerRecentMatches para %s: %v (solo reglas sin historial)", accountID, err)
+		}
+		ctx.Recent = recent
+	}
+	return dota.DetectHighlights(match, player, ctx)
+}
+
+// applyHighlights añade las líneas de partida destacada (en lang) al principio de la descripción y cambia el color del embed.
+func applyHighlights(lang i18n.Lang, embed *discordgo.MessageEmbed, highlights []dota.Highlight) {
+	if len(highlights) == 0 {
+		return
+	}
+	lines := make([]string, len(highlights))
+	for idx, h := range highlights {
+		lines[idx] = h.Text(lang)
+	}
+	embed.Description = "**" + strings.Join(lines, " • ") + "**\n" + embed.Description
+	embed.Color = highlightColor
+}
@@ -0,0 +1,261 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	historyPageSize       = 10  // partidas por página del historial
+	historyDefaultMatches = 20  // partidas cargadas si no se indica cantidad
+	historyMaxMatches     = 100 // máximo que permite Stratz en matches(request: { take })
+)
+
+// historyMinValue es el mínimo de la opción cantidad (MinValue requiere puntero).
+var historyMinValue = 1.0
+
+// Custom IDs de los componentes del historial. El estado (cuenta, cantidad, página) va codificado en el ID,
+// así los botones siguen funcionando tras reiniciar el bot.
+const (
+	historyPageCustomID  = "history_page"  // history_page:<account_id>:<cantidad>:<página>
+	historyMatchCustomID = "history_match" // history_match:<account_id>
+)
+
+// handleHistorySlash lista las últimas partidas de un jugador: /dota history [usuario:@usuario] [cantidad:N].
+func (b *Bot) handleHistorySlash(s *discordgo.Session, i *discordgo.InteractionCreate, subcommand *discordgo.ApplicationCommandInteractionDataOption) {
+	targetUser := interactionUser(i)
+	count := historyDefaultMatches
+	for _, option := range subcommand.Options {
+		switch option.Name {
+		case "usuario":
+			targetUser = option.UserValue(s)
+		case "cantidad":
+			count = int(option.IntValue())
+		}
+	}
+	if targetUser == nil {
+		b.sendFollowup(s, i, b.t(i, "err.user_unknown"))
+		return
+	}
+	if count <= 0 || count > historyMaxMatches {
+		count = historyMaxMatches
+	}
+
+	if b.stratzClient == nil || !b.stratzClient.IsConfigured() {
+		b.sendFollowup(s, i, b.t(i, "err.stratz_not_configured"))
+		return
+	}
+
+	accountID, ok := b.userStore.Get(targetUser.ID)
+	if !ok {
+		b.sendFollowup(s, i, b.t(i, "err.not_registered", targetUser.Username, targetUser.Username))
+		return
+	}
+	accountIDInt, errParse := strconv.ParseInt(accountID, 10, 64)
+	if errParse != nil {
+		b.sendFollowup(s, i, b.t(i, "err.invalid_account_id"))
+		return
+	}
+
+	matches, err := b.stratzClient.GetPlayerRecentMatches(accountIDInt, count)
+	if err != nil {
+		getLogger().Errorf("history: GetPlayerRecentMatches para %s: %v", accountID, err)
+		b.sendFollowup(s, i, b.t(i, "err.matches", err))
+		return
+	}
+	if len(matches) == 0 {
+		b.sendFollowup(s, i, b.t(i, "err.no_recent", targetUser.Username))
+		return
+	}
+
+	embed, components := b.buildHistoryPage(b.lang(i), accountIDInt, matches, count, 0)
+	_, err = s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+		Embeds:     []*discordgo.MessageEmbed{embed},
+		Components: components,
+	})
+	if err != nil {
+		getLogger().Errorf("Error enviando historial: %v", err)
+	}
+}
+
+// handleHistoryPageComponent responde a los botones Anterior/Siguiente editando el mensaje del historial.
+func (b *Bot) handleHistoryPageComponent(s *discordgo.Session, i *discordgo.InteractionCreate, args []string) {
+	if len(args) != 3 {
+		return
+	}
+	accountIDInt, errAcc := strconv.ParseInt(args[0], 10, 64)
+	count, errCount := strconv.Atoi(args[1])
+	page, errPage := strconv.Atoi(args[2])
+	if errAcc != nil || errCount != nil || errPage != nil {
+		getLogger().Debugf("history: custom_id inválido: %v", args)
+		return
+	}
+
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredMessageUpdate,
+	}); err != nil {
+		getLogger().Errorf("Error respondiendo a componente: %v", err)
+		return
+	}
+
+	matches, err := b.stratzClient.GetPlayerRecentMatches(accountIDInt, count)
+	if err != nil || len(matches) == 0 {
+		getLogger().Errorf("history: GetPlayerRecentMatches para %d: %v", accountIDInt, err)
+		// El mensaje del historial queda como estaba: el error solo lo ve quien pulsó el botón
+		if _, errSend := s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+			Content: b.t(i, "history.page_error"),
+			Flags:   discordgo.MessageFlagsEphemeral,
+		}); errSend != nil {
+			getLogger().Errorf("Error enviando followup: %v", errSend)
+		}
+		return
+	}
+
+	embed, components := b.buildHistoryPage(b.lang(i), accountIDInt, matches, count, page)
+	embeds := []*discordgo.MessageEmbed{embed}
+	if _, err := s.InteractionResponseEdit(i.Interaction, &discordgo.WebhookEdit{
+		Embeds:     &embeds,
+		Components: &components,
+	}); err != nil {
+		getLogger().Errorf("Error actualizando historial: %v", err)
+	}
+}
+
+// handleHistoryMatchComponent responde al select menu del historial con el embed completo de la partida elegida.
+func (b *Bot) handleHistoryMatchComponent(s *discordgo.Session, i *discordgo.InteractionCreate, args []string) {
+	values := i.MessageComponentData().Values
+	if len(args) != 1 || len(values) == 0 {
+		return
+	}
+	accountID := args[0]
+	accountIDInt, errAcc := strconv.ParseInt(accountID, 10, 64)
+	matchID, errMatch := strconv.ParseInt(values[0], 10, 64)
+	if errAcc != nil || errMatch != nil {
+		getLogger().Debugf("history: selección inválida: %v %v", args, values)
+		return
+	}
+
+	if err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseDeferredChannelMessageWithSource,
+	}); err != nil {
+		getLogger().Errorf("Error respondiendo a componente: %v", err)
+		return
+	}
+
+	matchStratz, err := b.stratzClient.GetMatch(matchID)
+	if err != nil || matchStratz == nil {
+		getLogger().Errorf("history: GetMatch %d: %v", matchID, err)
+		b.sendFollowup(s, i, b.t(i, "err.match", matchID))
+		return
+	}
+	match := dota.StratzMatchToMatchResponse(matchStratz)
+	lang := b.lang(i)
+	embed, ok := b.buildMatchEmbedForAccount(lang, match, accountID, accountIDInt)
+	if !ok {
+		b.sendFollowup(s, i, b.t(i, "err.player_not_in_match", accountID, matchID))
+		return
+	}
+	embeds, files := b.matchAttachments(lang, embed, match, matchPlayerIsRadiant(match, accountIDInt), i18n.T(lang, "match.your_team"))
+	b.sendFollowupEmbeds(s, i, embeds, files)
+}
+
+// buildHistoryPage construye el embed de una página del historial (textos en lang) con sus botones y el select menu de partidas.
+func (b *Bot) buildHistoryPage(lang i18n.Lang, accountID int64, matches []dota.StratzMatch, count, page int) (*discordgo.MessageEmbed, []discordgo.MessageComponent) {
+	totalPages := (len(matches) + historyPageSize - 1) / historyPageSize
+	if page < 0 {
+		page = 0
+	}
+	if page >= totalPages {
+		page = totalPages - 1
+	}
+	start := page * historyPageSize
+	end := start + historyPageSize
+	if end > len(matches) {
+		end = len(matches)
+	}
+
+	playerName := ""
+	avatarURL := ""
+	var lines []string
+	var options []discordgo.SelectMenuOption
+	for idx := start; idx < end; idx++ {
+		m := &matches[idx]
+		p := dota.FindStratzPlayer(m, accountID)
+		if p == nil {
+			continue
+		}
+		if playerName == "" && p.SteamAccount != nil {
+			playerName = p.SteamAccount.Name
+			avatarURL = dota.NormalizeSteamAvatarURL(p.SteamAccount.Avatar)
+		}
+		won := dota.IsStratzPlayerWin(m, p)
+		result := "❌"
+		resultText := i18n.T(lang, "history.loss")
+		if won {
+			result = "✅"
+			resultText = i18n.T(lang, "history.win")
+		}
+		heroName := b.dotaClient.GetHeroName(p.HeroID)
+		mode := dota.GameModeDisplayName(b.dotaClient.GetGameModeName(int(m.GameMode)))
+		lines = append(lines, fmt.Sprintf("%s **%s** %d/%d/%d · %s · %s · [%d](https://stratz.com/matches/%d)",
+			result, heroName, p.Kills, p.Deaths, p.Assists, dota.FormatDuration(m.DurationSeconds), mode, m.ID, m.ID))
+		options = append(options, discordgo.SelectMenuOption{
+			Label:       fmt.Sprintf("%s — %s", heroName, resultText),
+			Value:       strconv.FormatInt(m.ID, 10),
+			Description: fmt.Sprintf("%d/%d/%d · %s · %s", p.Kills, p.Deaths, p.Assists, mode, time.Unix(m.StartDateTime, 0).Format("2006-01-02 15:04")),
+		})
+	}
+	if playerName == "" {
+		playerName = i18n.T(lang, "player.default")
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       i18n.T(lang, "history.title", playerName),
+		Description: strings.Join(lines, "\n"),
+		Color:       0x3498db,
+		URL:         fmt.Sprintf("https://stratz.com/players/%d", accountID),
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: i18n.T(lang, "history.footer", page+1, totalPages, len(matches)),
+		},
+	}
+	if avatarURL != "" {
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: avatarURL}
+	}
+
+	pageID := func(p int) string {
+		return fmt.Sprintf("%s:%d:%d:%d", historyPageCustomID, accountID, count, p)
+	}
+	components := []discordgo.MessageComponent{
+		discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.Button{
+				Label:    i18n.T(lang, "history.previous"),
+				Style:    discordgo.SecondaryButton,
+				CustomID: pageID(page - 1),
+				Disabled: page == 0,
+			},
+			discordgo.Button{
+				Label:    i18n.T(lang, "history.next"),
+				Style:    discordgo.SecondaryButton,
+				CustomID: pageID(page + 1),
+				Disabled: page >= totalPages-1,
+			},
+		}},
+	}
+	if len(options) > 0 {
+		components = append(components, discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+			discordgo.SelectMenu{
+				MenuType:    discordgo.StringSelectMenu,
+				CustomID:    fmt.Sprintf("%s:%d", historyMatchCustomID, accountID),
+				Placeholder: i18n.T(lang, "history.select"),
+				Options:     options,
+			},
+		}})
+	}
+	return embed, components
+}
@@ -0,0 +1,208 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// localizedLocales son los locales de Discord que reciben nombres/descripciones traducidos en el comando;
+// el texto base del comando (registerCommands) está en español.
+var localizedLocales = map[i18n.Lang][]discordgo.Locale{
+	i18n.English: {discordgo.EnglishUS, discordgo.EnglishGB},
+}
+
+// lang devuelve el idioma de la respuesta a una interacción: preferencia guardada del usuario,
+// si no el idioma de su cliente de Discord, si no el del servidor y si no el por defecto.
+func (b *Bot) lang(i *discordgo.InteractionCreate) i18n.Lang {
+	if user := interactionUser(i); user != nil {
+		if lang, ok := i18n.Parse(b.userStore.GetUserLanguage(user.ID)); ok {
+			return lang
+		}
+	}
+	if lang, ok := i18n.Parse(string(i.Locale)); ok {
+		return lang
+	}
+	return b.guildLang(i.GuildID)
+}
+
+// guildLang devuelve el idioma por defecto del servidor (configurado con /dota language servidor:true,
+// si no el locale del servidor en Discord); para mensajes sin interacción (notificaciones, scheduler).
+func (b *Bot) guildLang(guildID string) i18n.Lang {
+	if guildID == "" {
+		return i18n.Default
+	}
+	if lang, ok := i18n.Parse(b.userStore.GetGuildLanguage(guildID)); ok {
+		return lang
+	}
+	if guild, err := b.session.State.Guild(guildID); err == nil {
+		if lang, ok := i18n.Parse(guild.PreferredLocale); ok {
+			return lang
+		}
+	}
+	return i18n.Default
+}
+
+// channelGuild devuelve el servidor al que pertenece el canal (SERVER_ID si no está en caché).
+func (b *Bot) channelGuild(channelID string) string {
+	if channel, err := b.session.State.Channel(channelID); err == nil && channel.GuildID != "" {
+		return channel.GuildID
+	}
+	return b.cfg().ServerID
+}
+
+// channelLang devuelve el idioma del servidor al que pertenece el canal.
+func (b *Bot) channelLang(channelID string) i18n.Lang {
+	return b.guildLang(b.channelGuild(channelID))
+}
+
+// t traduce key al idioma de la interacción
+func (b *Bot) t(i *discordgo.InteractionCreate, key string, args ...interface{}) string {
+	return i18n.T(b.lang(i), key, args...)
+}
+
+// localizeCommands añade a /dota y a sus subcomandos, opciones y valores fijos los nombres y descripciones traducidos.
+// Claves: cmd.dota.desc, cmd.<sub>.desc, cmd.<sub>.<opción>.desc (o opt.<opción>.desc si es común) y choice.<opción>.<valor>;
+// nombres: cmd.<sub>.name y cmd.<sub>.<opción>.name (o opt.<opción>.name). En grupos, <sub> es grupo.subcomando
+// (ej. cmd.template.edit.json.desc). Discord envía siempre el nombre base, así que los handlers no cambian.
+func localizeCommands(commands []*discordgo.ApplicationCommand) {
+	for _, cmd := range commands {
+		descriptions := make(map[discordgo.Locale]string)
+		forEachLocale("cmd."+cmd.Name+".desc", descriptions)
+		cmd.DescriptionLocalizations = &descriptions
+		for _, sub := range cmd.Options {
+			localizeSubcommand(sub.Name, sub)
+		}
+	}
+}
+
+// localizeSubcommand traduce un subcomando o grupo; path es "template" o "template.edit" para subcomandos de un grupo.
+func localizeSubcommand(path string, sub *discordgo.ApplicationCommandOption) {
+	sub.NameLocalizations = make(map[discordgo.Locale]string)
+	forEachLocale("cmd."+path+".name", sub.NameLocalizations)
+	sub.DescriptionLocalizations = make(map[discordgo.Locale]string)
+	forEachLocale("cmd."+path+".desc", sub.DescriptionLocalizations)
+	for _, option := range sub.Options {
+		if option.Type == discordgo.ApplicationCommandOptionSubCommand {
+			localizeSubcommand(path+"."+option.Name, option)
+			continue
+		}
+		localizeOption(path, option)
+	}
+}
+
+func localizeOption(subcommand string, option *discordgo.ApplicationCommandOption) {
+	option.NameLocalizations = make(map[discordgo.Locale]string)
+	nameKey := "cmd." + subcommand + "." + option.Name + ".name"
+	if !i18n.Has(i18n.English, nameKey) {
+		nameKey = "opt." + option.Name + ".name"
+	}
+	forEachLocale(nameKey, option.NameLocalizations)
+	option.DescriptionLocalizations = make(map[discordgo.Locale]string)
+	key := "cmd." + subcommand + "." + option.Name + ".desc"
+	if !i18n.Has(i18n.English, key) {
+		key = "opt." + option.Name + ".desc"
+	}
+	if option.Name == "parche" {
+		for lang, locales := range localizedLocales {
+			for _, locale := range locales {
+				option.DescriptionLocalizations[locale] = i18n.T(lang, key, dota.StatsPatchDays())
+			}
+		}
+	} else {
+		forEachLocale(key, option.DescriptionLocalizations)
+	}
+	for _, choice := range option.Choices {
+		choice.NameLocalizations = make(map[discordgo.Locale]string)
+		forEachLocale(fmt.Sprintf("choice.%s.%v", option.Name, choice.Value), choice.NameLocalizations)
+	}
+}
+
+// optionName devuelve el nombre con el que lang ve la opción name (el propio name si no está traducido)
+func optionName(lang i18n.Lang, name string) string {
+	if key := "opt." + name + ".name"; i18n.Has(lang, key) {
+		return i18n.T(lang, key)
+	}
+	return name
+}
+
+// forEachLocale guarda en out la traducción de key para cada locale localizado (solo si el idioma la tiene)
+func forEachLocale(key string, out map[discordgo.Locale]string) {
+	for lang, locales := range localizedLocales {
+		if !i18n.Has(lang, key) {
+			continue
+		}
+		for _, locale := range locales {
+			out[locale] = i18n.T(lang, key)
+		}
+	}
+}
+
+// hasManageServer indica si quien ejecuta la interacción tiene el permiso Gestionar servidor
+func hasManageServer(i *discordgo.InteractionCreate) bool {
+	return i.Member != nil && i.Member.Permissions&discordgo.PermissionManageGuild != 0
+}
+
+// handleLanguageSlash cambia el idioma de las respuestas: /dota language idioma:<es|en|auto> [servidor:true].
+// Sin servidor guarda la preferencia del usuario (auto la borra); con servidor:true, el idioma por defecto del servidor.
+func (b *Bot) handleLanguageSlash(s *discordgo.Session, i *discordgo.InteractionCreate, subcommand *discordgo.ApplicationCommandInteractionDataOption) {
+	var value string
+	guild := false
+	for _, option := range subcommand.Options {
+		switch option.Name {
+		case "idioma":
+			value = option.StringValue()
+		case "servidor":
+			guild = option.BoolValue()
+		}
+	}
+
+	lang, ok := i18n.Parse(value)
+	if !ok && value != "auto" {
+		b.sendFollowup(s, i, b.t(i, "language.invalid", value))
+		return
+	}
+
+	if guild {
+		switch {
+		case i.GuildID == "":
+			b.sendFollowup(s, i, b.t(i, "language.guild_only"))
+		case !hasManageServer(i):
+			b.sendFollowup(s, i, b.t(i, "language.need_manage"))
+		case !ok:
+			b.sendFollowup(s, i, b.t(i, "language.guild_auto"))
+		default:
+			if err := b.userStore.SetGuildLanguage(i.GuildID, string(lang)); err != nil {
+				getLogger().Errorf("Error guardando idioma del servidor: %v", err)
+				b.sendFollowup(s, i, b.t(i, "language.save_error"))
+				return
+			}
+			getLogger().Infof("Idioma del servidor %s: %s", i.GuildID, lang)
+			b.sendFollowup(s, i, b.t(i, "language.set_guild", lang.Name()))
+		}
+		return
+	}
+
+	user := interactionUser(i)
+	if user == nil {
+		b.sendFollowup(s, i, b.t(i, "err.user_unknown"))
+		return
+	}
+	stored := ""
+	if ok {
+		stored = string(lang)
+	}
+	if err := b.userStore.SetUserLanguage(user.ID, stored); err != nil {
+		getLogger().Errorf("Error guardando idioma de %s: %v", user.ID, err)
+		b.sendFollowup(s, i, b.t(i, "language.save_error"))
+		return
+	}
+	current := b.lang(i)
+	if ok {
+		b.sendFollowup(s, i, i18n.T(current, "language.set_user", current.Name()))
+	} else {
+		b.sendFollowup(s, i, i18n.T(current, "language.cleared_user", current.Name()))
+	}
+}
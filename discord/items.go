@@ -0,0 +1,61 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// itemTimingsMax es el número de items grandes con timing mostrados en el campo Items.
+const itemTimingsMax = 4
+
+// buildItemsField devuelve el campo Items (título en lang) con el inventario final, mochila, neutral y timings de items grandes
+// (nil si Stratz no devolvió items).
+func (b *Bot) buildItemsField(lang i18n.Lang, player *dota.Player) *discordgo.MessageEmbedField {
+	names := func(ids []int) []string {
+		var out []string
+		for _, id := range ids {
+			if id != 0 {
+				out = append(out, b.dotaClient.GetItemName(id))
+			}
+		}
+		return out
+	}
+
+	var lines []string
+	if inventory := names(player.Items); len(inventory) > 0 {
+		lines = append(lines, strings.Join(inventory, " · "))
+	}
+	if backpack := names(player.Backpack); len(backpack) > 0 {
+		lines = append(lines, "🎒 "+strings.Join(backpack, " · "))
+	}
+	if player.NeutralItem != 0 {
+		lines = append(lines, "🌿 "+b.dotaClient.GetItemName(player.NeutralItem))
+	}
+	if timings := b.dotaClient.KeyItemTimings(player.ItemPurchases, itemTimingsMax); len(timings) > 0 {
+		parts := make([]string, len(timings))
+		for idx, t := range timings {
+			parts[idx] = fmt.Sprintf("%s %s", b.dotaClient.GetItemName(t.ItemID), formatGameTime(t.Time))
+		}
+		lines = append(lines, "⏱️ "+strings.Join(parts, " · "))
+	}
+	if len(lines) == 0 {
+		return nil
+	}
+	return &discordgo.MessageEmbedField{
+		Name:   i18n.T(lang, "match.items"),
+		Value:  joinFieldLines(lines),
+		Inline: false,
+	}
+}
+
+// formatGameTime formatea segundos de partida como m:ss ("-0:30" antes del horn).
+func formatGameTime(seconds int) string {
+	if seconds < 0 {
+		return "-" + dota.FormatDuration(-seconds)
+	}
+	return dota.FormatDuration(seconds)
+}
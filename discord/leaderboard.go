@@ -0,0 +1,179 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// leaderboardPeriods mapea la opción periodo a su duración; la etiqueta es la clave leaderboard.period.<periodo>.
+var leaderboardPeriods = map[string]time.Duration{
+	"dia":    24 * time.Hour,
+	"semana": 7 * 24 * time.Hour,
+	"mes":    30 * 24 * time.Hour,
+}
+
+// leaderboardMetrics son las métricas del leaderboard; el título es la clave leaderboard.metric.<métrica>.
+var leaderboardMetrics = map[string]bool{
+	"winrate":  true,
+	"kda":      true,
+	"gpm":      true,
+	"partidas": true,
+	"racha":    true,
+	"rango":    true,
+}
+
+// leaderboardMedals son las medallas para los tres primeros puestos.
+var leaderboardMedals = []string{"🥇", "🥈", "🥉"}
+
+type leaderboardEntry struct {
+	discordID string
+	value     float64
+	display   string
+}
+
+// handleLeaderboardSlash rankea a todos los registrados en un solo embed:
+// /dota leaderboard metrica:<winrate|kda|gpm|partidas|racha|rango> [periodo:<dia|semana|mes>].
+func (b *Bot) handleLeaderboardSlash(s *discordgo.Session, i *discordgo.InteractionCreate, subcommand *discordgo.ApplicationCommandInteractionDataOption) {
+	metric := ""
+	period := "semana"
+	for _, option := range subcommand.Options {
+		switch option.Name {
+		case "metrica":
+			metric = option.StringValue()
+		case "periodo":
+			period = option.StringValue()
+		}
+	}
+	periodDuration, okPeriod := leaderboardPeriods[period]
+	if !leaderboardMetrics[metric] || !okPeriod {
+		b.sendFollowup(s, i, b.t(i, "leaderboard.usage"))
+		return
+	}
+	lang := b.lang(i)
+	periodLabel := i18n.T(lang, "leaderboard.period."+period)
+
+	if b.stratzClient == nil || !b.stratzClient.IsConfigured() {
+		b.sendFollowup(s, i, b.t(i, "err.stratz_not_configured"))
+		return
+	}
+	users := b.userStore.GetAll()
+	if len(users) == 0 {
+		b.sendFollowup(s, i, b.t(i, "err.no_users"))
+		return
+	}
+
+	minGames := b.cfg().StatsMinGames
+	since := time.Now().Add(-periodDuration)
+	var entries []leaderboardEntry
+	belowMin := 0
+	for discordID, accountID := range users {
+		accountIDInt, errParse := strconv.ParseInt(accountID, 10, 64)
+		if errParse != nil {
+			getLogger().Debugf("leaderboard: account_id inválido omitido: %s", accountID)
+			continue
+		}
+
+		if metric == "rango" {
+			profile, err := b.stratzClient.GetPlayerProfile(accountIDInt)
+			if err != nil || profile == nil {
+				getLogger().Errorf("leaderboard: GetPlayerProfile para %s: %v", accountID, err)
+				continue
+			}
+			entries = append(entries, leaderboardEntry{
+				discordID: discordID,
+				value:     float64(dota.RankSteps(profile.RankBracket, profile.Rank)),
+				display:   formatRank(lang, profile.RankBracket, profile.Rank),
+			})
+			continue
+		}
+
+		matches, err := b.stratzClient.GetPlayerRecentMatches(accountIDInt, historyMaxMatches)
+		if err != nil {
+			getLogger().Errorf("leaderboard: GetPlayerRecentMatches para %s: %v", accountID, err)
+			continue
+		}
+		matches = dota.FilterMatchesSince(matches, since)
+		summary := dota.SummarizePlayerMatches(matches, accountIDInt)
+		if summary.Games == 0 {
+			continue
+		}
+		record := fmt.Sprintf("%d-%d", summary.Wins, summary.Losses())
+
+		entry := leaderboardEntry{discordID: discordID}
+		switch metric {
+		case "winrate":
+			entry.value = summary.WinRate()
+			entry.display = fmt.Sprintf("%.1f%% (%s)", entry.value, record)
+		case "kda":
+			entry.value = summary.KDA()
+			entry.display = fmt.Sprintf("%.2f (%.1f/%.1f/%.1f)", entry.value, summary.AvgKills(), summary.AvgDeaths(), summary.AvgAssists())
+		case "gpm":
+			entry.value = summary.AvgGPM()
+			entry.display = fmt.Sprintf("%.0f", entry.value)
+		case "partidas":
+			entry.value = float64(summary.Games)
+			entry.display = fmt.Sprintf("%d (%s)", summary.Games, record)
+		case "racha":
+			streak := dota.AnalyzeStreakFromStratzMatches(matches, accountIDInt)
+			entry.value = float64(streak.StreakCount)
+			if !streak.IsWinStreak {
+				entry.value = -entry.value
+			}
+			entry.display = formatStreak(lang, streak)
+		}
+		// El mínimo de partidas solo aplica a métricas de promedio/porcentaje
+		if (metric == "winrate" || metric == "kda" || metric == "gpm") && summary.Games < minGames {
+			belowMin++
+			continue
+		}
+		entries = append(entries, entry)
+	}
+
+	if len(entries) == 0 {
+		b.sendFollowup(s, i, i18n.T(lang, "leaderboard.no_data", minGames, periodLabel))
+		return
+	}
+
+	sort.SliceStable(entries, func(a, c int) bool { return entries[a].value > entries[c].value })
+
+	var lines []string
+	for idx, e := range entries {
+		position := fmt.Sprintf("**%d.**", idx+1)
+		if idx < len(leaderboardMedals) {
+			position = leaderboardMedals[idx]
+		}
+		lines = append(lines, fmt.Sprintf("%s <@%s> — %s", position, e.discordID, e.display))
+	}
+
+	footer := i18n.T(lang, "leaderboard.players", len(entries))
+	if metric != "rango" {
+		footer = fmt.Sprintf("%s • %s", periodLabel, footer)
+	}
+	if belowMin > 0 {
+		footer += " • " + i18n.T(lang, "leaderboard.below_min", belowMin, minGames)
+	}
+	footer += " • Stratz"
+
+	b.sendFollowupEmbed(s, i, &discordgo.MessageEmbed{
+		Title:       fmt.Sprintf("🏆 Leaderboard — %s", i18n.T(lang, "leaderboard.metric."+metric)),
+		Description: truncateDescription(strings.Join(lines, "\n")),
+		Color:       0xf1c40f,
+		Footer:      &discordgo.MessageEmbedFooter{Text: footer},
+	})
+}
+
+// truncateDescription recorta la descripción al máximo que usamos en embeds (4000 caracteres).
+func truncateDescription(description string) string {
+	const maxDesc = 4000
+	if len(description) > maxDesc {
+		return description[:maxDesc-3] + "..."
+	}
+	return description
+}
@@ -0,0 +1,261 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// handleMatchSlash muestra el reporte de cualquier partida: /dota match id:<match_id> [jugador:@usuario].
+// Con jugador (registrado) usa el mismo embed que la notificación automática; sin él, un scoreboard neutral de ambos equipos.
+func (b *Bot) handleMatchSlash(s *discordgo.Session, i *discordgo.InteractionCreate, subcommand *discordgo.ApplicationCommandInteractionDataOption) {
+	var matchID int64
+	var targetUser *discordgo.User
+	for _, option := range subcommand.Options {
+		switch option.Name {
+		case "id":
+			matchID = option.IntValue()
+		case "jugador":
+			targetUser = option.UserValue(s)
+		}
+	}
+
+	if matchID <= 0 {
+		b.sendFollowup(s, i, b.t(i, "match.usage"))
+		return
+	}
+
+	if b.stratzClient == nil || !b.stratzClient.IsConfigured() {
+		b.sendFollowup(s, i, b.t(i, "err.stratz_not_configured"))
+		return
+	}
+
+	matchStratz, err := b.stratzClient.GetMatch(matchID)
+	if err != nil {
+		getLogger().Errorf("match: GetMatch %d: %v", matchID, err)
+		b.sendFollowup(s, i, b.t(i, "err.match_detail", matchID, err))
+		return
+	}
+	if matchStratz == nil || len(matchStratz.Players) == 0 {
+		b.sendFollowup(s, i, b.t(i, "err.match_not_found", matchID))
+		return
+	}
+	match := dota.StratzMatchToMatchResponse(matchStratz)
+
+	lang := b.lang(i)
+	if targetUser == nil {
+		embed := b.buildMatchScoreboardEmbed(lang, match)
+		embeds, files := b.matchAttachments(lang, embed, match, true, "Radiant")
+		b.sendFollowupEmbeds(s, i, embeds, files)
+		return
+	}
+
+	accountID, ok := b.userStore.Get(targetUser.ID)
+	if !ok {
+		b.sendFollowup(s, i, b.t(i, "err.not_registered", targetUser.Username, targetUser.Username))
+		return
+	}
+	accountIDInt, errParse := strconv.ParseInt(accountID, 10, 64)
+	if errParse != nil {
+		b.sendFollowup(s, i, b.t(i, "err.invalid_account_id"))
+		return
+	}
+
+	embed, ok := b.buildMatchEmbedForAccount(lang, match, accountID, accountIDInt)
+	if !ok {
+		b.sendFollowup(s, i, b.t(i, "match.not_played", targetUser.Username, matchID))
+		return
+	}
+	embeds, files := b.matchAttachments(lang, embed, match, matchPlayerIsRadiant(match, accountIDInt), i18n.T(lang, "match.your_team"))
+	b.sendFollowupEmbeds(s, i, embeds, files)
+}
+
+// handleLastSlash muestra la última partida de un usuario registrado: /dota last [usuario:@usuario] [privado:true].
+// No espera a que la partida esté parseada (a diferencia del poller con PARSED=true).
+func (b *Bot) handleLastSlash(s *discordgo.Session, i *discordgo.InteractionCreate, subcommand *discordgo.ApplicationCommandInteractionDataOption) {
+	targetUser := interactionUser(i)
+	for _, option := range subcommand.Options {
+		if option.Name == "usuario" {
+			targetUser = option.UserValue(s)
+		}
+	}
+	if targetUser == nil {
+		b.sendFollowup(s, i, b.t(i, "err.user_unknown"))
+		return
+	}
+
+	if b.stratzClient == nil || !b.stratzClient.IsConfigured() {
+		b.sendFollowup(s, i, b.t(i, "err.stratz_not_configured"))
+		return
+	}
+
+	accountID, ok := b.userStore.Get(targetUser.ID)
+	if !ok {
+		b.sendFollowup(s, i, b.t(i, "err.not_registered", targetUser.Username, targetUser.Username))
+		return
+	}
+	accountIDInt, errParse := strconv.ParseInt(accountID, 10, 64)
+	if errParse != nil {
+		b.sendFollowup(s, i, b.t(i, "err.invalid_account_id"))
+		return
+	}
+
+	matches, err := b.stratzClient.GetPlayerRecentMatches(accountIDInt, 1)
+	if err != nil {
+		getLogger().Errorf("last: GetPlayerRecentMatches para %s: %v", accountID, err)
+		b.sendFollowup(s, i, b.t(i, "err.matches", err))
+		return
+	}
+	if len(matches) == 0 {
+		b.sendFollowup(s, i, b.t(i, "err.no_recent", targetUser.Username))
+		return
+	}
+
+	matchStratz, err := b.stratzClient.GetMatch(matches[0].ID)
+	if err != nil || matchStratz == nil {
+		getLogger().Errorf("last: GetMatch %d: %v", matches[0].ID, err)
+		b.sendFollowup(s, i, b.t(i, "err.match", matches[0].ID))
+		return
+	}
+
+	match := dota.StratzMatchToMatchResponse(matchStratz)
+	lang := b.lang(i)
+	embed, ok := b.buildMatchEmbedForAccount(lang, match, accountID, accountIDInt)
+	if !ok {
+		b.sendFollowup(s, i, b.t(i, "err.player_not_in_match", accountID, matchStratz.ID))
+		return
+	}
+	embeds, files := b.matchAttachments(lang, embed, match, matchPlayerIsRadiant(match, accountIDInt), i18n.T(lang, "match.your_team"))
+	b.sendFollowupEmbeds(s, i, embeds, files)
+}
+
+// buildMatchEmbedForAccount busca al jugador en la partida y construye el embed de notificación desde su perspectiva.
+// Devuelve false si el jugador no está en la partida.
+func (b *Bot) buildMatchEmbedForAccount(lang i18n.Lang, match *dota.MatchResponse, accountID string, accountIDInt int64) (*discordgo.MessageEmbed, bool) {
+	var player *dota.Player
+	for j := range match.Players {
+		if match.Players[j].AccountID == int(accountIDInt) {
+			player = &match.Players[j]
+			break
+		}
+	}
+	if player == nil {
+		return nil, false
+	}
+
+	profileStratz, _ := b.stratzClient.GetPlayerProfile(accountIDInt)
+	profile := b.buildPlayerProfile(accountID, accountIDInt, profileStratz)
+	return b.buildMatchEmbed(lang, match, player, profile, accountID), true
+}
+
+// buildMatchScoreboardEmbed construye un scoreboard neutral: los 10 héroes con K/D/A y net worth, y el resultado por línea.
+func (b *Bot) buildMatchScoreboardEmbed(lang i18n.Lang, match *dota.MatchResponse) *discordgo.MessageEmbed {
+	radiantWin := match.RadiantWin != nil && *match.RadiantWin
+	winner := "🌙 " + i18n.T(lang, "lane.dire_victory")
+	if radiantWin {
+		winner = "☀️ " + i18n.T(lang, "lane.radiant_victory")
+	}
+
+	gameModeDisplayName := dota.GameModeDisplayName(b.dotaClient.GetGameModeName(match.GameMode))
+	description := fmt.Sprintf("**%s** | %s | %s", winner, gameModeDisplayName, dota.FormatDuration(match.Duration))
+
+	var radiant, dire []string
+	for _, p := range match.Players {
+		line := b.formatScoreboardLine(lang, p)
+		if p.PlayerSlot < 128 {
+			radiant = append(radiant, line)
+		} else {
+			dire = append(dire, line)
+		}
+	}
+
+	radiantName := "☀️ Radiant"
+	direName := "🌙 Dire"
+	if radiantWin {
+		radiantName += " 🏆"
+	} else {
+		direName += " 🏆"
+	}
+
+	laneSummary := fmt.Sprintf("Top: %s\nMid: %s\nBottom: %s",
+		formatLaneOutcomeEnum(lang, match.TopLaneOutcome),
+		formatLaneOutcomeEnum(lang, match.MidLaneOutcome),
+		formatLaneOutcomeEnum(lang, match.BottomLaneOutcome))
+
+	return &discordgo.MessageEmbed{
+		Title:       i18n.T(lang, "match.title", match.MatchID),
+		Description: description,
+		Color:       0x3498db,
+		URL:         fmt.Sprintf("https://stratz.com/matches/%d", match.MatchID),
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   radiantName,
+				Value:  joinFieldLines(radiant),
+				Inline: false,
+			},
+			{
+				Name:   direName,
+				Value:  joinFieldLines(dire),
+				Inline: false,
+			},
+			{
+				Name:   "Score",
+				Value:  fmt.Sprintf("Radiant %d - %d Dire", match.RadiantScore, match.DireScore),
+				Inline: true,
+			},
+			{
+				Name:   i18n.T(lang, "match.lane_results"),
+				Value:  laneSummary,
+				Inline: true,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: fmt.Sprintf("Match ID: %d • Stratz", match.MatchID),
+		},
+	}
+}
+
+// formatScoreboardLine devuelve "Héroe — Jugador | K/D/A | NW" para una fila del scoreboard.
+func (b *Bot) formatScoreboardLine(lang i18n.Lang, p dota.Player) string {
+	heroName := b.dotaClient.GetHeroName(p.HeroID)
+	playerName := i18n.T(lang, "scoreboard.anonymous")
+	if p.AccountID != 0 {
+		playerName = p.Personaname
+		if playerName == "" {
+			playerName = i18n.T(lang, "scoreboard.player_id", p.AccountID)
+		}
+		playerName = fmt.Sprintf("[%s](https://stratz.com/players/%d)", playerName, p.AccountID)
+	}
+	return fmt.Sprintf("**%s** — %s | %d/%d/%d | %s NW", heroName, playerName, p.Kills, p.Deaths, p.Assists, formatThousands(p.NetWorth))
+}
+
+// formatThousands abrevia valores grandes (ej. 23456 -> 23.5k).
+func formatThousands(n int) string {
+	if n < 1000 {
+		return strconv.Itoa(n)
+	}
+	return fmt.Sprintf("%.1fk", float64(n)/1000)
+}
+
+// joinFieldLines une líneas para el valor de un campo respetando el límite de 1024 caracteres de Discord.
+func joinFieldLines(lines []string) string {
+	const maxFieldLength = 1024
+	if len(lines) == 0 {
+		return "—"
+	}
+	var sb strings.Builder
+	for _, line := range lines {
+		if sb.Len()+len(line)+1 > maxFieldLength {
+			break
+		}
+		if sb.Len() > 0 {
+			sb.WriteString("\n")
+		}
+		sb.WriteString(line)
+	}
+	return sb.String()
+}
@@ -0,0 +1,42 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"fmt"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// buildMVPFields devuelve los campos de MVP y peor actuación de la partida en lang (nil si no hay jugadores).
+func (b *Bot) buildMVPFields(lang i18n.Lang, match *dota.MatchResponse) []*discordgo.MessageEmbedField {
+	mvp, worst, ok := dota.MVPAndWorst(match)
+	if !ok {
+		return nil
+	}
+	return []*discordgo.MessageEmbedField{
+		{
+			Name:   i18n.T(lang, "match.mvp"),
+			Value:  b.formatPlayerScore(lang, mvp),
+			Inline: true,
+		},
+		{
+			Name:   i18n.T(lang, "match.worst"),
+			Value:  b.formatPlayerScore(lang, worst),
+			Inline: true,
+		},
+	}
+}
+
+// formatPlayerScore devuelve "**Hero** — Jugador\n12/3/20 • 78 pts".
+func (b *Bot) formatPlayerScore(lang i18n.Lang, ps dota.PlayerScore) string {
+	p := ps.Player
+	playerName := p.Personaname
+	if playerName == "" {
+		playerName = i18n.T(lang, "scoreboard.anonymous")
+	}
+	if p.AccountID != 0 {
+		playerName = fmt.Sprintf("[%s](https://stratz.com/players/%d)", playerName, p.AccountID)
+	}
+	return fmt.Sprintf("**%s** — %s\n%d/%d/%d • %.0f pts", b.dotaClient.GetHeroName(p.HeroID), playerName, p.Kills, p.Deaths, p.Assists, ps.Score)
+}
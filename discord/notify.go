@@ -0,0 +1,142 @@
+package discord
+
+import (
+	"bytes"
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"dota-discord-bot/storage"
+	"fmt"
+	"io"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Valores de la opción resultado de /dota notify (guardados en NotifyPrefs.Result; all se guarda vacío)
+const (
+	notifyResultAll  = "all"
+	notifyResultWin  = "win"
+	notifyResultLoss = "loss"
+)
+
+// handleNotifySlash cambia las preferencias de notificación del usuario que ejecuta el comando:
+// /dota notify [activo] [resultado] [solo_ranked] [solo_destacadas] [mencion] [md]. Sin opciones muestra las actuales.
+func (b *Bot) handleNotifySlash(s *discordgo.Session, i *discordgo.InteractionCreate, subcommand *discordgo.ApplicationCommandInteractionDataOption) {
+	user := interactionUser(i)
+	if user == nil {
+		b.sendFollowup(s, i, b.t(i, "err.user_unknown"))
+		return
+	}
+	if _, ok := b.userStore.Get(user.ID); !ok {
+		b.sendFollowup(s, i, b.t(i, "notify.not_registered"))
+		return
+	}
+
+	prefs := b.userStore.GetNotifyPrefs(user.ID)
+	if len(subcommand.Options) == 0 {
+		b.sendFollowup(s, i, b.t(i, "notify.current")+"\n"+formatNotifyPrefs(b.lang(i), prefs))
+		return
+	}
+	for _, option := range subcommand.Options {
+		switch option.Name {
+		case "activo":
+			prefs.Disabled = !option.BoolValue()
+		case "resultado":
+			switch value := option.StringValue(); value {
+			case notifyResultAll:
+				prefs.Result = ""
+			case notifyResultWin, notifyResultLoss:
+				prefs.Result = value
+			default:
+				b.sendFollowup(s, i, b.t(i, "notify.invalid_result", value))
+				return
+			}
+		case "solo_ranked":
+			prefs.RankedOnly = option.BoolValue()
+		case "solo_destacadas":
+			prefs.HighlightsOnly = option.BoolValue()
+		case "mencion":
+			prefs.Mention = option.BoolValue()
+		case "md":
+			prefs.DM = option.BoolValue()
+		}
+	}
+
+	if err := b.userStore.SetNotifyPrefs(user.ID, prefs); err != nil {
+		getLogger().Errorf("Error guardando preferencias de notificación de %s: %v", user.ID, err)
+		b.sendFollowup(s, i, b.t(i, "notify.save_error"))
+		return
+	}
+	getLogger().Infof("Preferencias de notificación de %s: %+v", user.Username, prefs)
+	b.sendFollowup(s, i, b.t(i, "notify.saved")+"\n"+formatNotifyPrefs(b.lang(i), prefs))
+}
+
+// formatNotifyPrefs lista las preferencias en lang, una por línea
+func formatNotifyPrefs(lang i18n.Lang, prefs storage.NotifyPrefs) string {
+	yesNo := func(value bool) string {
+		if value {
+			return i18n.T(lang, "notify.yes")
+		}
+		return i18n.T(lang, "notify.no")
+	}
+	result := i18n.T(lang, "notify.result_all")
+	switch prefs.Result {
+	case notifyResultWin:
+		result = i18n.T(lang, "notify.result_win")
+	case notifyResultLoss:
+		result = i18n.T(lang, "notify.result_loss")
+	}
+	lines := []string{
+		fmt.Sprintf("• %s: %s", i18n.T(lang, "notify.enabled"), yesNo(!prefs.Disabled)),
+		fmt.Sprintf("• %s: %s", i18n.T(lang, "notify.result"), result),
+		fmt.Sprintf("• %s: %s", i18n.T(lang, "notify.ranked_only"), yesNo(prefs.RankedOnly)),
+		fmt.Sprintf("• %s: %s", i18n.T(lang, "notify.highlights_only"), yesNo(prefs.HighlightsOnly)),
+		fmt.Sprintf("• %s: %s", i18n.T(lang, "notify.mention"), yesNo(prefs.Mention)),
+		fmt.Sprintf("• %s: %s", i18n.T(lang, "notify.dm"), yesNo(prefs.DM)),
+	}
+	return strings.Join(lines, "\n")
+}
+
+// notifySkipReason devuelve por qué las preferencias descartan la partida ("" si hay que notificarla).
+// won es el resultado para el jugador y highlights las reglas de partida destacada que cumple.
+func notifySkipReason(prefs storage.NotifyPrefs, match *dota.MatchResponse, won bool, highlights []dota.Highlight) string {
+	switch {
+	case prefs.Disabled:
+		return "notificaciones desactivadas"
+	case prefs.Result == notifyResultWin && !won:
+		return "solo victorias"
+	case prefs.Result == notifyResultLoss && won:
+		return "solo derrotas"
+	case prefs.RankedOnly && match.LobbyType != dota.LobbyTypeRanked:
+		return "solo ranked"
+	case prefs.HighlightsOnly && len(highlights) == 0:
+		return "solo destacadas"
+	}
+	return ""
+}
+
+// sendDirectCopy envía al usuario por mensaje directo una copia de la notificación (sin menciones).
+// Falla si el usuario no acepta mensajes directos de miembros del servidor.
+func (b *Bot) sendDirectCopy(discordID string, embeds []*discordgo.MessageEmbed, files []*discordgo.File) error {
+	channel, err := b.session.UserChannelCreate(discordID)
+	if err != nil {
+		return fmt.Errorf("abriendo MD: %w", err)
+	}
+	_, err = b.session.ChannelMessageSendComplex(channel.ID, &discordgo.MessageSend{Embeds: embeds, Files: files})
+	return err
+}
+
+// copyFiles duplica los adjuntos para poder enviarlos en dos mensajes (cada envío consume el Reader).
+func copyFiles(files []*discordgo.File) []*discordgo.File {
+	copies := make([]*discordgo.File, 0, len(files))
+	for _, file := range files {
+		data, err := io.ReadAll(file.Reader)
+		if err != nil {
+			getLogger().Warnf("Adjunto %s no copiado: %v", file.Name, err)
+			continue
+		}
+		file.Reader = bytes.NewReader(data)
+		copies = append(copies, &discordgo.File{Name: file.Name, ContentType: file.ContentType, Reader: bytes.NewReader(data)})
+	}
+	return copies
+}
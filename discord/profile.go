@@ -0,0 +1,188 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// profileFormMatches es el número de partidas usadas para la forma reciente y la racha del perfil.
+const profileFormMatches = 20
+
+// rankBracketMedals mapea el rankBracket de Stratz a emoji + nombre legible (sin calibrar se traduce aparte).
+var rankBracketMedals = map[string]string{
+	"HERALD":   "🟤 Herald",
+	"GUARDIAN": "⚪ Guardian",
+	"CRUSADER": "🟢 Crusader",
+	"ARCHON":   "🔵 Archon",
+	"LEGEND":   "🟣 Legend",
+	"ANCIENT":  "🟠 Ancient",
+	"DIVINE":   "🔶 Divine",
+	"IMMORTAL": "🔴 Immortal",
+}
+
+// formatRankBracket devuelve la medalla con emoji para un rankBracket de Stratz ("—" si no hay rango).
+func formatRankBracket(lang i18n.Lang, bracket string) string {
+	if bracket == "" {
+		return "—"
+	}
+	if strings.EqualFold(bracket, "UNCALIBRATED") {
+		return "❔ " + i18n.T(lang, "rank.uncalibrated")
+	}
+	if medal, ok := rankBracketMedals[strings.ToUpper(bracket)]; ok {
+		return medal
+	}
+	return bracket
+}
+
+// formatWinLossSparkline devuelve la forma reciente como emojis (🟩 victoria, 🟥 derrota), de más antigua a más reciente.
+// Las partidas vienen de Stratz de más reciente a más antigua.
+func formatWinLossSparkline(matches []dota.StratzMatch, steamAccountID int64) (sparkline string, wins, losses int) {
+	var sb strings.Builder
+	for idx := len(matches) - 1; idx >= 0; idx-- {
+		p := dota.FindStratzPlayer(&matches[idx], steamAccountID)
+		if p == nil {
+			continue
+		}
+		if dota.IsStratzPlayerWin(&matches[idx], p) {
+			sb.WriteString("🟩")
+			wins++
+		} else {
+			sb.WriteString("🟥")
+			losses++
+		}
+	}
+	return sb.String(), wins, losses
+}
+
+// handleProfileSlash muestra la tarjeta de perfil: /dota profile [usuario:@usuario].
+func (b *Bot) handleProfileSlash(s *discordgo.Session, i *discordgo.InteractionCreate, subcommand *discordgo.ApplicationCommandInteractionDataOption) {
+	targetUser := interactionUser(i)
+	for _, option := range subcommand.Options {
+		if option.Name == "usuario" {
+			targetUser = option.UserValue(s)
+		}
+	}
+	if targetUser == nil {
+		b.sendFollowup(s, i, b.t(i, "err.user_unknown"))
+		return
+	}
+
+	if b.stratzClient == nil || !b.stratzClient.IsConfigured() {
+		b.sendFollowup(s, i, b.t(i, "err.stratz_not_configured"))
+		return
+	}
+
+	accountID, ok := b.userStore.Get(targetUser.ID)
+	if !ok {
+		b.sendFollowup(s, i, b.t(i, "err.not_registered", targetUser.Username, targetUser.Username))
+		return
+	}
+	accountIDInt, errParse := strconv.ParseInt(accountID, 10, 64)
+	if errParse != nil {
+		b.sendFollowup(s, i, b.t(i, "err.invalid_account_id"))
+		return
+	}
+
+	profile, err := b.stratzClient.GetPlayerProfile(accountIDInt)
+	if err != nil || profile == nil {
+		getLogger().Errorf("profile: GetPlayerProfile para %s: %v", accountID, err)
+		b.sendFollowup(s, i, b.t(i, "profile.error", err))
+		return
+	}
+	recent, err := b.stratzClient.GetPlayerRecentMatches(accountIDInt, profileFormMatches)
+	if err != nil {
+		getLogger().Warnf("profile: GetPlayerRecentMatches para %s: %v", accountID, err)
+	}
+	heroStats, err := b.stratzClient.GetPlayerHeroStats(accountIDInt, b.cfg().StatsMinGames, b.cfg().StatsTake)
+	if err != nil {
+		getLogger().Warnf("profile: GetPlayerHeroStats para %s: %v", accountID, err)
+	}
+
+	b.sendFollowupEmbed(s, i, b.buildProfileEmbed(b.lang(i), profile, recent, heroStats))
+}
+
+// buildProfileEmbed construye la tarjeta de perfil en lang: W/L histórico, medalla, racha, top 5 héroes y forma reciente.
+func (b *Bot) buildProfileEmbed(lang i18n.Lang, profile *dota.StratzPlayerStats, recent []dota.StratzMatch, heroStats []dota.StratzHeroStats) *discordgo.MessageEmbed {
+	displayName := profile.Name
+	if displayName == "" {
+		displayName = i18n.T(lang, "player.default")
+	}
+
+	lifetimeText := "—"
+	if profile.MatchCount > 0 {
+		losses := profile.MatchCount - profile.WinCount
+		winRate := 100 * float64(profile.WinCount) / float64(profile.MatchCount)
+		lifetimeText = i18n.T(lang, "profile.lifetime", profile.WinCount, losses, winRate, profile.MatchCount)
+	}
+
+	streakText := i18n.T(lang, "common.no_matches")
+	formText := streakText
+	if len(recent) > 0 {
+		streakText = formatStreak(lang, dota.AnalyzeStreakFromStratzMatches(recent, profile.SteamAccountID))
+		sparkline, wins, losses := formatWinLossSparkline(recent, profile.SteamAccountID)
+		if wins+losses > 0 {
+			formText = i18n.T(lang, "profile.form", sparkline, wins, losses, 100*float64(wins)/float64(wins+losses))
+		}
+	}
+
+	heroesText := i18n.T(lang, "profile.no_heroes", b.cfg().StatsMinGames)
+	if len(heroStats) > 0 {
+		var lines []string
+		for idx, h := range heroStats {
+			if idx >= 5 {
+				break
+			}
+			winPct := 0.0
+			if h.MatchCount > 0 {
+				winPct = 100 * float64(h.WinCount) / float64(h.MatchCount)
+			}
+			lines = append(lines, fmt.Sprintf("%d. **%s** | %d-%d | %.1f%%", idx+1, b.dotaClient.GetHeroName(h.HeroID), h.WinCount, h.MatchCount-h.WinCount, winPct))
+		}
+		heroesText = strings.Join(lines, "\n")
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title: i18n.T(lang, "profile.title", displayName),
+		URL:   fmt.Sprintf("https://stratz.com/players/%d", profile.SteamAccountID),
+		Color: 0x3498db,
+		Fields: []*discordgo.MessageEmbedField{
+			{
+				Name:   i18n.T(lang, "profile.rank"),
+				Value:  formatRank(lang, profile.RankBracket, profile.Rank),
+				Inline: true,
+			},
+			{
+				Name:   i18n.T(lang, "profile.lifetime_title"),
+				Value:  lifetimeText,
+				Inline: true,
+			},
+			{
+				Name:   i18n.T(lang, "profile.streak"),
+				Value:  streakText,
+				Inline: true,
+			},
+			{
+				Name:   i18n.T(lang, "profile.form_title", profileFormMatches),
+				Value:  formText,
+				Inline: false,
+			},
+			{
+				Name:   i18n.T(lang, "profile.top_heroes"),
+				Value:  heroesText,
+				Inline: false,
+			},
+		},
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: i18n.T(lang, "profile.footer", b.cfg().StatsTake, b.cfg().StatsMinGames),
+		},
+	}
+	if profile.Avatar != "" {
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: profile.Avatar}
+	}
+	return embed
+}
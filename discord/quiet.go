@@ -0,0 +1,239 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"dota-discord-bot/storage"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// quietTimeLayout es el formato de las opciones desde/hasta de /dota quiet set
+const quietTimeLayout = "15:04"
+
+// digestMaxPlayers es el máximo de jugadores en el resumen (un campo del embed por jugador)
+const digestMaxPlayers = 25
+
+// handleQuietSlash gestiona las horas de silencio del servidor: /dota quiet set|off|show.
+// Durante la ventana las partidas se encolan y al terminar se publica un único resumen en el canal de notificaciones.
+func (b *Bot) handleQuietSlash(s *discordgo.Session, i *discordgo.InteractionCreate, group *discordgo.ApplicationCommandInteractionDataOption) {
+	if len(group.Options) == 0 {
+		b.sendFollowup(s, i, b.t(i, "err.invalid_command"))
+		return
+	}
+	if i.GuildID == "" {
+		b.sendFollowup(s, i, b.t(i, "quiet.guild_only"))
+		return
+	}
+	action := group.Options[0]
+	if action.Name != "show" && !hasManageServer(i) {
+		b.sendFollowup(s, i, b.t(i, "err.need_manage"))
+		return
+	}
+
+	switch action.Name {
+	case "set":
+		var start, end, timezone string
+		for _, option := range action.Options {
+			switch option.Name {
+			case "desde":
+				start = option.StringValue()
+			case "hasta":
+				end = option.StringValue()
+			case "zona":
+				timezone = option.StringValue()
+			}
+		}
+		quiet, errMsg := parseQuietHours(b.lang(i), start, end, timezone)
+		if errMsg != "" {
+			b.sendFollowup(s, i, errMsg)
+			return
+		}
+		if err := b.userStore.SetQuietHours(i.GuildID, &quiet); err != nil {
+			getLogger().Errorf("Error guardando horas de silencio: %v", err)
+			b.sendFollowup(s, i, b.t(i, "quiet.save_error"))
+			return
+		}
+		getLogger().Infof("Horas de silencio en %s: %s-%s (%s)", i.GuildID, quiet.Start, quiet.End, quiet.Timezone)
+		b.sendFollowup(s, i, b.t(i, "quiet.saved", quiet.Start, quiet.End, quiet.Timezone))
+	case "off":
+		if err := b.userStore.SetQuietHours(i.GuildID, nil); err != nil {
+			getLogger().Errorf("Error desactivando horas de silencio: %v", err)
+			b.sendFollowup(s, i, b.t(i, "quiet.save_error"))
+			return
+		}
+		getLogger().Infof("Horas de silencio desactivadas en %s", i.GuildID)
+		b.sendFollowup(s, i, b.t(i, "quiet.off"))
+	case "show":
+		queued := len(b.userStore.GetDigest(i.GuildID))
+		quiet, ok := b.userStore.GetQuietHours(i.GuildID)
+		if !ok {
+			b.sendFollowup(s, i, b.t(i, "quiet.none", queued))
+			return
+		}
+		status := b.t(i, "quiet.inactive")
+		if quietActive(quiet, time.Now()) {
+			status = b.t(i, "quiet.active")
+		}
+		b.sendFollowup(s, i, b.t(i, "quiet.current", quiet.Start, quiet.End, quiet.Timezone, status, queued))
+	default:
+		b.sendFollowup(s, i, b.t(i, "err.unknown_command"))
+	}
+}
+
+// parseQuietHours valida desde/hasta (HH:MM, distintos) y la zona horaria IANA; errMsg es el mensaje para el usuario.
+func parseQuietHours(lang i18n.Lang, start, end, timezone string) (quiet storage.QuietHours, errMsg string) {
+	startTime, err := time.Parse(quietTimeLayout, start)
+	if err != nil {
+		return quiet, i18n.T(lang, "quiet.invalid_time", start)
+	}
+	endTime, err := time.Parse(quietTimeLayout, end)
+	if err != nil {
+		return quiet, i18n.T(lang, "quiet.invalid_time", end)
+	}
+	if startTime.Equal(endTime) {
+		return quiet, i18n.T(lang, "quiet.empty_window")
+	}
+	if _, err := time.LoadLocation(timezone); err != nil || timezone == "" {
+		return quiet, i18n.T(lang, "quiet.invalid_zone", timezone)
+	}
+	return storage.QuietHours{
+		Start:    startTime.Format(quietTimeLayout),
+		End:      endTime.Format(quietTimeLayout),
+		Timezone: timezone,
+	}, ""
+}
+
+// quietActive indica si now cae dentro de la ventana [Start, End) en la zona de quiet (la ventana puede cruzar la medianoche).
+func quietActive(quiet storage.QuietHours, now time.Time) bool {
+	loc, err := time.LoadLocation(quiet.Timezone)
+	if err != nil {
+		getLogger().Warnf("Zona horaria de horas de silencio inválida (%q): %v", quiet.Timezone, err)
+		return false
+	}
+	startTime, errStart := time.Parse(quietTimeLayout, quiet.Start)
+	endTime, errEnd := time.Parse(quietTimeLayout, quiet.End)
+	if errStart != nil || errEnd != nil {
+		return false
+	}
+	local := now.In(loc)
+	minute := local.Hour()*60 + local.Minute()
+	start := startTime.Hour()*60 + startTime.Minute()
+	end := endTime.Hour()*60 + endTime.Minute()
+	if start < end {
+		return minute >= start && minute < end
+	}
+	return minute >= start || minute < end
+}
+
+// isQuiet indica si el servidor está ahora en sus horas de silencio
+func (b *Bot) isQuiet(guildID string, now time.Time) bool {
+	if guildID == "" {
+		return false
+	}
+	quiet, ok := b.userStore.GetQuietHours(guildID)
+	return ok && quietActive(quiet, now)
+}
+
+// enqueueDigest guarda la partida de player para el resumen del servidor en lugar de notificarla.
+func (b *Bot) enqueueDigest(guildID, discordID, accountID string, match *dota.MatchResponse, player *dota.Player, profile *dota.PlayersResponse, won bool) error {
+	name := player.Personaname
+	if profile != nil && profile.Profile.Personaname != "" {
+		name = profile.Profile.Personaname
+	}
+	return b.userStore.EnqueueDigest(guildID, storage.QueuedMatch{
+		DiscordID:  discordID,
+		AccountID:  accountID,
+		PlayerName: name,
+		MatchID:    match.MatchID,
+		HeroID:     player.HeroID,
+		Won:        won,
+		Kills:      player.Kills,
+		Deaths:     player.Deaths,
+		Assists:    player.Assists,
+		Duration:   match.Duration,
+	})
+}
+
+// flushDigests publica en channelID el resumen de cada servidor con partidas encoladas cuyas horas de silencio
+// han terminado (o se desactivaron). Si el envío falla, la cola se conserva para el siguiente ciclo.
+func (b *Bot) flushDigests(channelID string) {
+	now := time.Now()
+	for _, guildID := range b.userStore.DigestGuilds() {
+		if b.isQuiet(guildID, now) {
+			continue
+		}
+		queued := b.userStore.GetDigest(guildID)
+		embed := b.buildDigestEmbed(b.guildLang(guildID), queued)
+		if _, err := b.session.ChannelMessageSendEmbed(channelID, embed); err != nil {
+			getLogger().Errorf("Error enviando resumen de horas de silencio de %s: %v", guildID, err)
+			continue
+		}
+		getLogger().Infof("Resumen de horas de silencio de %s publicado (%d partidas)", guildID, len(queued))
+		if err := b.userStore.ClearDigest(guildID); err != nil {
+			getLogger().Errorf("Error vaciando partidas encoladas de %s: %v", guildID, err)
+		}
+	}
+}
+
+// digestPlayer agrega las partidas encoladas de un jugador
+type digestPlayer struct {
+	discordID string
+	name      string
+	games     int
+	wins      int
+	duration  int
+	best      dota.GameHighlight // partida con mayor KDA
+}
+
+// buildDigestEmbed resume las partidas encoladas por jugador (en orden de su primera partida): partidas, W/L,
+// tiempo jugado y mejor partida por KDA.
+func (b *Bot) buildDigestEmbed(lang i18n.Lang, queued []storage.QueuedMatch) *discordgo.MessageEmbed {
+	var players []*digestPlayer
+	byID := make(map[string]*digestPlayer)
+	for _, q := range queued {
+		p, ok := byID[q.DiscordID]
+		if !ok {
+			p = &digestPlayer{discordID: q.DiscordID, name: q.PlayerName}
+			byID[q.DiscordID] = p
+			players = append(players, p)
+		}
+		game := dota.GameHighlight{MatchID: q.MatchID, HeroID: q.HeroID, Kills: q.Kills, Deaths: q.Deaths, Assists: q.Assists, Won: q.Won}
+		game.KDA = float64(q.Kills + q.Assists)
+		if q.Deaths > 0 {
+			game.KDA /= float64(q.Deaths)
+		}
+		if p.games == 0 || game.KDA > p.best.KDA {
+			p.best = game
+		}
+		p.games++
+		if q.Won {
+			p.wins++
+		}
+		p.duration += q.Duration
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       i18n.T(lang, "digest.title"),
+		Description: i18n.T(lang, "digest.description", len(queued), len(players)),
+		Color:       0x2c3e50,
+		Timestamp:   time.Now().Format(time.RFC3339),
+	}
+	for n, p := range players {
+		if n == digestMaxPlayers {
+			embed.Footer = &discordgo.MessageEmbedFooter{Text: i18n.T(lang, "match.and_more")}
+			break
+		}
+		name := p.name
+		if name == "" {
+			name = i18n.T(lang, "player.default")
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name: name,
+			Value: i18n.T(lang, "digest.player", p.discordID, p.games, p.wins, p.games-p.wins, dota.FormatDuration(p.duration)) + "\n" +
+				b.formatGameHighlight(lang, p.best, i18n.T(lang, "digest.best")),
+		})
+	}
+	return embed
+}
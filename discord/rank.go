@@ -0,0 +1,180 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"dota-discord-bot/storage"
+	"fmt"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// rankTimelineMax es el máximo de cambios de rango listados en /dota rank.
+const rankTimelineMax = 20
+
+// formatRank devuelve la medalla con estrellas (ej. "🟣 Legend 4"); sin seasonRank, solo la medalla.
+func formatRank(lang i18n.Lang, bracket string, rank int) string {
+	text := formatRankBracket(lang, bracket)
+	if stars := dota.RankStars(rank); stars > 0 {
+		text += " " + strconv.Itoa(stars)
+	}
+	return text
+}
+
+// notificationChannel devuelve el canal de notificaciones configurado (o el de la configuración), "" si no hay.
+func (b *Bot) notificationChannel() string {
+	channelID, err := b.userStore.GetChannel()
+	if err != nil || channelID == "" {
+		channelID = b.cfg().NotificationChannelID
+	}
+	if !isValidSnowflake(channelID) {
+		return ""
+	}
+	return channelID
+}
+
+// trackRank guarda el rango actual del jugador en el historial y, si cambió de medalla,
+// anuncia el ascenso/descenso en channelID (sin anuncio si channelID está vacío).
+func (b *Bot) trackRank(channelID, discordID, accountID string, profile *dota.StratzPlayerStats) {
+	if profile == nil || profile.RankBracket == "" {
+		return
+	}
+	current := storage.RankSnapshot{
+		RankBracket: profile.RankBracket,
+		Rank:        profile.Rank,
+		Time:        time.Now().Unix(),
+	}
+	previous, hadPrevious, changed, err := b.userStore.AddRankSnapshot(accountID, current)
+	if err != nil {
+		getLogger().Errorf("Error guardando rango de %s: %v", accountID, err)
+	}
+	if !changed || !hadPrevious || channelID == "" {
+		return
+	}
+	if dota.RankBracketOrder(previous.RankBracket) == dota.RankBracketOrder(current.RankBracket) {
+		getLogger().Debugf("Rango de %s: %d -> %d (misma medalla)", accountID, previous.Rank, current.Rank)
+		return
+	}
+
+	embed := b.buildRankChangeEmbed(b.channelLang(channelID), discordID, profile, previous, current)
+	if _, err := b.session.ChannelMessageSendEmbed(channelID, embed); err != nil {
+		getLogger().Errorf("Error enviando cambio de rango de %s: %v", accountID, err)
+	}
+}
+
+// buildRankChangeEmbed construye el anuncio de ascenso/descenso de medalla en lang (ej. "Archon 5 → Legend 1 🎉").
+func (b *Bot) buildRankChangeEmbed(lang i18n.Lang, discordID string, profile *dota.StratzPlayerStats, previous, current storage.RankSnapshot) *discordgo.MessageEmbed {
+	promoted := dota.RankSteps(current.RankBracket, current.Rank) > dota.RankSteps(previous.RankBracket, previous.Rank)
+	title := i18n.T(lang, "rank.demoted")
+	suffix := "💀"
+	color := 0xe74c3c
+	if promoted {
+		title = i18n.T(lang, "rank.promoted")
+		suffix = "🎉"
+		color = 0x2ecc71
+	}
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		URL:         fmt.Sprintf("https://stratz.com/players/%d", profile.SteamAccountID),
+		Description: fmt.Sprintf("<@%s>\n**%s → %s** %s", discordID, formatRank(lang, previous.RankBracket, previous.Rank), formatRank(lang, current.RankBracket, current.Rank), suffix),
+		Color:       color,
+		Footer:      &discordgo.MessageEmbedFooter{Text: i18n.T(lang, "rank.change_footer")},
+		Timestamp:   time.Now().Format(time.RFC3339),
+	}
+	if profile.Avatar != "" {
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: profile.Avatar}
+	}
+	return embed
+}
+
+// handleRankSlash muestra la evolución del rango de un jugador: /dota rank [usuario:@usuario].
+func (b *Bot) handleRankSlash(s *discordgo.Session, i *discordgo.InteractionCreate, subcommand *discordgo.ApplicationCommandInteractionDataOption) {
+	targetUser := interactionUser(i)
+	for _, option := range subcommand.Options {
+		if option.Name == "usuario" {
+			targetUser = option.UserValue(s)
+		}
+	}
+	if targetUser == nil {
+		b.sendFollowup(s, i, b.t(i, "err.user_unknown"))
+		return
+	}
+	if b.stratzClient == nil || !b.stratzClient.IsConfigured() {
+		b.sendFollowup(s, i, b.t(i, "err.stratz_not_configured"))
+		return
+	}
+	lang := b.lang(i)
+	accountIDInt, errMsg := b.registeredAccount(lang, targetUser)
+	if errMsg != "" {
+		b.sendFollowup(s, i, errMsg)
+		return
+	}
+	accountID := strconv.FormatInt(accountIDInt, 10)
+
+	profile, err := b.stratzClient.GetPlayerProfile(accountIDInt)
+	if err != nil {
+		getLogger().Errorf("rank: GetPlayerProfile para %s: %v", accountID, err)
+	}
+	// Solo guarda el rango: los ascensos y descensos los anuncia el poller, no una consulta
+	b.trackRank("", targetUser.ID, accountID, profile)
+
+	history := b.userStore.GetRankHistory(accountID)
+	if len(history) == 0 {
+		b.sendFollowup(s, i, i18n.T(lang, "rank.no_history", targetUser.Username))
+		return
+	}
+
+	var lines []string
+	for idx := len(history) - 1; idx >= 0 && len(lines) < rankTimelineMax; idx-- {
+		snap := history[idx]
+		arrow := "•"
+		if idx > 0 {
+			prev := history[idx-1]
+			if dota.RankSteps(snap.RankBracket, snap.Rank) > dota.RankSteps(prev.RankBracket, prev.Rank) {
+				arrow = "🔼"
+			} else {
+				arrow = "🔽"
+			}
+		}
+		lines = append(lines, fmt.Sprintf("%s <t:%d:d> — %s", arrow, snap.Time, formatRank(lang, snap.RankBracket, snap.Rank)))
+	}
+
+	latest := history[len(history)-1]
+	first := history[0]
+	steps := dota.RankSteps(latest.RankBracket, latest.Rank) - dota.RankSteps(first.RankBracket, first.Rank)
+	footer := i18n.T(lang, "rank.footer", len(history), time.Unix(first.Time, 0).Format(statsDateLayout))
+	if steps != 0 {
+		footer += " • " + i18n.T(lang, "rank.stars", steps)
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:       i18n.T(lang, "rank.title", targetUser.Username),
+		URL:         fmt.Sprintf("https://stratz.com/players/%d", accountIDInt),
+		Description: truncateDescription(i18n.T(lang, "rank.current", formatRank(lang, latest.RankBracket, latest.Rank), strings.Join(lines, "\n"))),
+		Color:       0x9b59b6,
+		Footer:      &discordgo.MessageEmbedFooter{Text: footer + " • Stratz"},
+	}
+	if profile != nil && profile.Avatar != "" {
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: profile.Avatar}
+	}
+	b.sendFollowupEmbed(s, i, embed)
+}
+
+// rankChangeSince devuelve el rango al inicio del periodo (último cambio anterior a since o, si no hay, el primero
+// registrado) y el rango más reciente. ok=false si no hay historial.
+func rankChangeSince(history []storage.RankSnapshot, since time.Time) (start, latest storage.RankSnapshot, ok bool) {
+	if len(history) == 0 {
+		return start, latest, false
+	}
+	start = history[0]
+	for _, snap := range history {
+		if snap.Time > since.Unix() {
+			break
+		}
+		start = snap
+	}
+	return start, history[len(history)-1], true
+}
@@ -0,0 +1,260 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// recapTopHeroes es el número de héroes listados en "Héroes más jugados" del recap.
+const recapTopHeroes = 5
+
+// recapPeriod describe un recap programado (semanal los lunes, mensual el día 1).
+// El título y la etiqueta son las claves recap.<key>.title y recap.<key>.label.
+type recapPeriod struct {
+	key   string
+	since func(now time.Time) time.Time
+}
+
+var (
+	recapWeekly = recapPeriod{
+		key:   "semanal",
+		since: func(now time.Time) time.Time { return now.AddDate(0, 0, -7) },
+	}
+	recapMonthly = recapPeriod{
+		key:   "mensual",
+		since: func(now time.Time) time.Time { return now.AddDate(0, -1, 0) },
+	}
+)
+
+// duePeriods devuelve los recaps que tocan hoy: semanal los lunes, mensual el día 1.
+func duePeriods(now time.Time) []recapPeriod {
+	var periods []recapPeriod
+	if now.Weekday() == time.Monday {
+		periods = append(periods, recapWeekly)
+	}
+	if now.Day() == 1 {
+		periods = append(periods, recapMonthly)
+	}
+	return periods
+}
+
+// sendRecap construye y envía el recap del periodo al canal de notificaciones.
+func (b *Bot) sendRecap(channelID string, period recapPeriod, now time.Time) {
+	histories, discordIDs := b.fetchRegisteredHistories(historyMaxMatches)
+	since := period.since(now)
+	for id, matches := range histories {
+		histories[id] = dota.FilterMatchesSince(matches, since)
+	}
+
+	b.refreshRanks(discordIDs)
+	embed := b.buildRecapEmbed(b.channelLang(channelID), period, since, histories, discordIDs)
+	if embed == nil {
+		getLogger().Infof("Recap %s: sin partidas de registrados en el periodo, omitiendo", period.key)
+		return
+	}
+	if _, err := b.session.ChannelMessageSendEmbed(channelID, embed); err != nil {
+		getLogger().Errorf("Recap %s: error enviando embed: %v", period.key, err)
+	}
+}
+
+// buildRecapEmbed construye el embed del recap en lang: partidas por jugador, mejor/peor partida, héroes más jugados,
+// racha más larga, mayor cambio de rango desde since y sinergia. Devuelve nil si nadie jugó en el periodo.
+func (b *Bot) buildRecapEmbed(lang i18n.Lang, period recapPeriod, since time.Time, histories map[int64][]dota.StratzMatch, discordIDs map[int64]string) *discordgo.MessageEmbed {
+	type playerRecap struct {
+		accountID int64
+		recap     dota.PlayerRecap
+	}
+	var recaps []playerRecap
+	heroGames := make(map[int]int)
+	heroWins := make(map[int]int)
+	for accountID, matches := range histories {
+		recap := dota.BuildPlayerRecap(matches, accountID)
+		if recap.Summary.Games == 0 {
+			continue
+		}
+		recaps = append(recaps, playerRecap{accountID: accountID, recap: recap})
+		for heroID, games := range recap.Summary.HeroGames {
+			heroGames[heroID] += games
+			heroWins[heroID] += recap.Summary.HeroWins[heroID]
+		}
+	}
+	if len(recaps) == 0 {
+		return nil
+	}
+	sort.Slice(recaps, func(a, c int) bool {
+		if recaps[a].recap.Summary.Games != recaps[c].recap.Summary.Games {
+			return recaps[a].recap.Summary.Games > recaps[c].recap.Summary.Games
+		}
+		return recaps[a].accountID < recaps[c].accountID
+	})
+
+	mention := func(accountID int64) string {
+		if discordID, ok := discordIDs[accountID]; ok {
+			return fmt.Sprintf("<@%s>", discordID)
+		}
+		return strconv.FormatInt(accountID, 10)
+	}
+
+	totalGames := 0
+	var playerLines []string
+	best := recaps[0].recap.Best
+	worst := recaps[0].recap.Worst
+	longest := recaps[0]
+	for _, r := range recaps {
+		s := r.recap.Summary
+		totalGames += s.Games
+		playerLines = append(playerLines, i18n.T(lang, "recap.player", mention(r.accountID), s.Games, s.Wins, s.Losses(), s.WinRate()))
+		if r.recap.Best.KDA > best.KDA {
+			best = r.recap.Best
+		}
+		if r.recap.Worst.KDA < worst.KDA {
+			worst = r.recap.Worst
+		}
+		if r.recap.LongestStreak > longest.recap.LongestStreak {
+			longest = r
+		}
+	}
+
+	fields := []*discordgo.MessageEmbedField{
+		{
+			Name:   i18n.T(lang, "recap.players"),
+			Value:  joinFieldLines(playerLines),
+			Inline: false,
+		},
+		{
+			Name:   i18n.T(lang, "recap.best"),
+			Value:  b.formatGameHighlight(lang, best, mention(best.SteamAccountID)),
+			Inline: true,
+		},
+		{
+			Name:   i18n.T(lang, "recap.worst"),
+			Value:  b.formatGameHighlight(lang, worst, mention(worst.SteamAccountID)),
+			Inline: true,
+		},
+	}
+
+	heroes := make([]dota.HeroCount, 0, len(heroGames))
+	for heroID, games := range heroGames {
+		heroes = append(heroes, dota.HeroCount{HeroID: heroID, Games: games, Wins: heroWins[heroID]})
+	}
+	sort.Slice(heroes, func(a, c int) bool {
+		if heroes[a].Games != heroes[c].Games {
+			return heroes[a].Games > heroes[c].Games
+		}
+		return heroes[a].HeroID < heroes[c].HeroID
+	})
+	var heroLines []string
+	for idx, h := range heroes {
+		if idx >= recapTopHeroes {
+			break
+		}
+		heroLines = append(heroLines, i18n.T(lang, "recap.hero", b.dotaClient.GetHeroName(h.HeroID), h.Games, 100*float64(h.Wins)/float64(h.Games)))
+	}
+	fields = append(fields, &discordgo.MessageEmbedField{
+		Name:   i18n.T(lang, "recap.heroes"),
+		Value:  joinFieldLines(heroLines),
+		Inline: false,
+	})
+
+	streakText := i18n.T(lang, "match.streak_loss", longest.recap.LongestStreak)
+	if longest.recap.LongestStreakWin {
+		streakText = i18n.T(lang, "match.streak_win", longest.recap.LongestStreak)
+	}
+	fields = append(fields, &discordgo.MessageEmbedField{
+		Name:   i18n.T(lang, "recap.longest_streak"),
+		Value:  fmt.Sprintf("%s — %s", mention(longest.accountID), streakText),
+		Inline: true,
+	})
+	fields = append(fields, &discordgo.MessageEmbedField{
+		Name:   i18n.T(lang, "recap.rank_change"),
+		Value:  b.recapRankChange(lang, since, discordIDs),
+		Inline: true,
+	})
+
+	report := dota.ComputeSynergy(histories)
+	fields = append(fields, b.buildSynergyFields(lang, report, discordIDs, b.cfg().StatsMinGames)...)
+
+	return &discordgo.MessageEmbed{
+		Title:       i18n.T(lang, "recap."+period.key+".title"),
+		Description: i18n.T(lang, "recap.description", totalGames, len(recaps), i18n.T(lang, "recap."+period.key+".label")),
+		Color:       0x9b59b6,
+		Fields:      fields,
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: i18n.T(lang, "recap.footer", b.cfg().StatsMinGames),
+		},
+		Timestamp: time.Now().Format(time.RFC3339),
+	}
+}
+
+// formatGameHighlight devuelve "<@id> — **Hero** 12/1/15 (27.0 KDA) ✅ · [partida](url)" con el enlace en lang.
+func (b *Bot) formatGameHighlight(lang i18n.Lang, game dota.GameHighlight, player string) string {
+	result := "❌"
+	if game.Won {
+		result = "✅"
+	}
+	return fmt.Sprintf("%s — **%s** %d/%d/%d (%.1f KDA) %s · [%s](https://stratz.com/matches/%d)",
+		player, b.dotaClient.GetHeroName(game.HeroID), game.Kills, game.Deaths, game.Assists, game.KDA, result, i18n.T(lang, "recap.match_link"), game.MatchID)
+}
+
+// refreshRanks guarda el rango actual de cada registrado en el historial antes del recap, sin anunciar cambios
+// (los anuncia el poller).
+func (b *Bot) refreshRanks(discordIDs map[int64]string) {
+	for accountIDInt, discordID := range discordIDs {
+		accountID := strconv.FormatInt(accountIDInt, 10)
+		profile, err := b.stratzClient.GetPlayerProfile(accountIDInt)
+		if err != nil {
+			getLogger().Errorf("Recap: GetPlayerProfile para %s: %v", accountID, err)
+			continue
+		}
+		b.trackRank("", discordID, accountID, profile)
+	}
+}
+
+// recapRankChange devuelve el mayor cambio de rango desde since según el historial de rangos.
+func (b *Bot) recapRankChange(lang i18n.Lang, since time.Time, discordIDs map[int64]string) string {
+	bestLine := ""
+	bestDelta := 0
+	for accountIDInt, discordID := range discordIDs {
+		accountID := strconv.FormatInt(accountIDInt, 10)
+		start, latest, ok := rankChangeSince(b.userStore.GetRankHistory(accountID), since)
+		if !ok {
+			continue
+		}
+		delta := dota.RankSteps(latest.RankBracket, latest.Rank) - dota.RankSteps(start.RankBracket, start.Rank)
+		if abs(delta) > abs(bestDelta) {
+			bestDelta = delta
+			bestLine = fmt.Sprintf("<@%s> — %s → %s", discordID, formatRank(lang, start.RankBracket, start.Rank), formatRank(lang, latest.RankBracket, latest.Rank))
+		}
+	}
+
+	if bestLine == "" {
+		return i18n.T(lang, "recap.no_rank_change")
+	}
+	if bestDelta > 0 {
+		return bestLine + " 📈"
+	}
+	return bestLine + " 📉"
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
+
+// recapPeriodNames devuelve "semanal y mensual" para los logs.
+func recapPeriodNames(periods []recapPeriod) string {
+	names := make([]string, len(periods))
+	for idx, p := range periods {
+		names[idx] = p.key
+	}
+	return strings.Join(names, " y ")
+}
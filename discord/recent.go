@@ -0,0 +1,65 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"sync"
+)
+
+// recentCache guarda las partidas recientes pedidas a Stratz durante un ciclo del poller, para que las reglas de
+// partida destacada, la racha, el W/L con el héroe y los resúmenes de sesión compartan una sola petición por jugador.
+// Solo guarda mientras hay un ciclo activo; un comando slash que llame a recentMatches durante el ciclo también lee
+// y rellena la caché (las partidas son las mismas), y fuera del ciclo siempre va a Stratz.
+type recentCache struct {
+	mu      sync.Mutex
+	active  bool
+	matches map[int64]recentEntry // account_id -> partidas pedidas
+}
+
+type recentEntry struct {
+	matches []dota.StratzMatch // más reciente primero
+	take    int                // take con el que se pidieron
+}
+
+// beginRecentCache activa la caché al empezar un ciclo del poller (vacía)
+func (b *Bot) beginRecentCache() {
+	b.recent.mu.Lock()
+	defer b.recent.mu.Unlock()
+	b.recent.active = true
+	b.recent.matches = make(map[int64]recentEntry)
+}
+
+// endRecentCache la desactiva al terminar el ciclo
+func (b *Bot) endRecentCache() {
+	b.recent.mu.Lock()
+	defer b.recent.mu.Unlock()
+	b.recent.active = false
+	b.recent.matches = nil
+}
+
+// cachedRecentMatches devuelve las partidas ya pedidas en este ciclo si cubren take (ok=false si no).
+func (b *Bot) cachedRecentMatches(accountID int64, take int) ([]dota.StratzMatch, bool) {
+	b.recent.mu.Lock()
+	defer b.recent.mu.Unlock()
+	entry, ok := b.recent.matches[accountID]
+	if !b.recent.active || !ok || entry.take < take {
+		return nil, false
+	}
+	return entry.matches[:min(take, len(entry.matches))], true
+}
+
+// recentMatches devuelve las últimas take partidas del jugador, reutilizando las del ciclo del poller si las cubren.
+func (b *Bot) recentMatches(accountID int64, take int) ([]dota.StratzMatch, error) {
+	if matches, ok := b.cachedRecentMatches(accountID, take); ok {
+		return matches, nil
+	}
+	matches, err := b.stratzClient.GetPlayerRecentMatches(accountID, take)
+	if err != nil {
+		return nil, err
+	}
+	b.recent.mu.Lock()
+	if b.recent.active && b.recent.matches[accountID].take < take {
+		b.recent.matches[accountID] = recentEntry{matches: matches, take: take}
+	}
+	b.recent.mu.Unlock()
+	return matches, nil
+}
@@ -0,0 +1,238 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"dota-discord-bot/storage"
+	"fmt"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// routeMaxRules es el máximo de reglas de enrutado por servidor
+const routeMaxRules = 25
+
+// routeMinNumber es el mínimo de la opción numero de /dota route remove (MinValue requiere puntero).
+var routeMinNumber = 1.0
+
+// routeAnyHighlight es el valor de la opción destacada que coincide con cualquier partida destacada
+const routeAnyHighlight = "any"
+
+// routeHighlightChoices son las opciones de destacada de /dota route add: cualquiera o una etiqueta concreta.
+func routeHighlightChoices() []*discordgo.ApplicationCommandOptionChoice {
+	choices := []*discordgo.ApplicationCommandOptionChoice{{Name: "Cualquiera", Value: routeAnyHighlight}}
+	for _, tag := range dota.HighlightTags {
+		choices = append(choices, &discordgo.ApplicationCommandOptionChoice{Name: string(tag), Value: string(tag)})
+	}
+	return choices
+}
+
+// handleRouteSlash gestiona las reglas de enrutado de notificaciones del servidor: /dota route add|remove|list.
+// Las reglas se evalúan en orden y la primera que coincide decide el canal; sin coincidencia se usa el canal de notificaciones.
+func (b *Bot) handleRouteSlash(s *discordgo.Session, i *discordgo.InteractionCreate, group *discordgo.ApplicationCommandInteractionDataOption) {
+	if len(group.Options) == 0 {
+		b.sendFollowup(s, i, b.t(i, "err.invalid_command"))
+		return
+	}
+	if i.GuildID == "" {
+		b.sendFollowup(s, i, b.t(i, "route.guild_only"))
+		return
+	}
+	action := group.Options[0]
+	if action.Name != "list" && !hasManageServer(i) {
+		b.sendFollowup(s, i, b.t(i, "err.need_manage"))
+		return
+	}
+
+	switch action.Name {
+	case "add":
+		b.addRoute(s, i, action)
+	case "remove":
+		number := 0
+		for _, option := range action.Options {
+			if option.Name == "numero" {
+				number = int(option.IntValue())
+			}
+		}
+		removed, ok, err := b.userStore.RemoveRoute(i.GuildID, number-1)
+		switch {
+		case err != nil:
+			getLogger().Errorf("Error borrando regla de enrutado: %v", err)
+			b.sendFollowup(s, i, b.t(i, "route.save_error"))
+		case !ok:
+			b.sendFollowup(s, i, b.t(i, "route.not_found", number))
+		default:
+			getLogger().Infof("Regla de enrutado %d borrada en %s", number, i.GuildID)
+			b.sendFollowup(s, i, b.t(i, "route.removed", number, b.describeRoute(b.lang(i), removed)))
+		}
+	case "list":
+		b.listRoutes(s, i)
+	default:
+		b.sendFollowup(s, i, b.t(i, "err.unknown_command"))
+	}
+}
+
+// addRoute crea una regla con las condiciones indicadas; exige al menos una condición además del canal.
+func (b *Bot) addRoute(s *discordgo.Session, i *discordgo.InteractionCreate, action *discordgo.ApplicationCommandInteractionDataOption) {
+	var rule storage.RouteRule
+	for _, option := range action.Options {
+		switch option.Name {
+		case "canal":
+			if channel := option.ChannelValue(nil); channel != nil {
+				rule.ChannelID = channel.ID
+			}
+		case "modo":
+			switch option.StringValue() {
+			case "ranked":
+				rule.LobbyTypes = append(rule.LobbyTypes, dota.LobbyTypeRanked)
+			case "normal":
+				rule.LobbyTypes = append(rule.LobbyTypes, dota.LobbyTypeNormal)
+			case "turbo":
+				rule.GameModes = append(rule.GameModes, dota.GameModeTurbo)
+			case "allpick":
+				rule.GameModes = append(rule.GameModes, dota.GameModeAllPick, dota.GameModeAllDraft)
+			}
+		case "modo_id":
+			rule.GameModes = append(rule.GameModes, int(option.IntValue()))
+		case "lobby_id":
+			rule.LobbyTypes = append(rule.LobbyTypes, int(option.IntValue()))
+		case "resultado":
+			if value := option.StringValue(); value != notifyResultAll {
+				rule.Result = value
+			}
+		case "usuario":
+			if user := option.UserValue(nil); user != nil {
+				rule.Player = user.ID
+			}
+		case "destacada":
+			rule.Highlight = option.StringValue()
+		}
+	}
+
+	if rule.ChannelID == "" {
+		b.sendFollowup(s, i, b.t(i, "channel.invalid"))
+		return
+	}
+	if len(rule.GameModes) == 0 && len(rule.LobbyTypes) == 0 && rule.Result == "" && rule.Player == "" && rule.Highlight == "" {
+		b.sendFollowup(s, i, b.t(i, "route.no_condition"))
+		return
+	}
+	if len(b.userStore.GetRoutes(i.GuildID)) >= routeMaxRules {
+		b.sendFollowup(s, i, b.t(i, "route.too_many", routeMaxRules))
+		return
+	}
+	if err := b.userStore.AddRoute(i.GuildID, rule); err != nil {
+		getLogger().Errorf("Error guardando regla de enrutado: %v", err)
+		b.sendFollowup(s, i, b.t(i, "route.save_error"))
+		return
+	}
+	number := len(b.userStore.GetRoutes(i.GuildID))
+	getLogger().Infof("Regla de enrutado %d añadida en %s: %+v", number, i.GuildID, rule)
+	b.sendFollowup(s, i, b.t(i, "route.added", number, b.describeRoute(b.lang(i), rule)))
+}
+
+// listRoutes muestra las reglas numeradas del servidor y el canal usado cuando ninguna coincide.
+func (b *Bot) listRoutes(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	lang := b.lang(i)
+	fallback := "—"
+	if channelID := b.notificationChannel(); channelID != "" {
+		fallback = fmt.Sprintf("<#%s>", channelID)
+	}
+	rules := b.userStore.GetRoutes(i.GuildID)
+	if len(rules) == 0 {
+		b.sendFollowup(s, i, i18n.T(lang, "route.empty", fallback))
+		return
+	}
+	lines := []string{i18n.T(lang, "route.list_title")}
+	for n, rule := range rules {
+		lines = append(lines, fmt.Sprintf("**%d.** %s", n+1, b.describeRoute(lang, rule)))
+	}
+	lines = append(lines, i18n.T(lang, "route.fallback", fallback))
+	b.sendFollowup(s, i, strings.Join(lines, "\n"))
+}
+
+// describeRoute resume la regla en una línea: condiciones → canal
+func (b *Bot) describeRoute(lang i18n.Lang, rule storage.RouteRule) string {
+	var conditions []string
+	if len(rule.GameModes) > 0 {
+		names := make([]string, 0, len(rule.GameModes))
+		for _, id := range rule.GameModes {
+			names = append(names, dota.GameModeDisplayName(b.dotaClient.GetGameModeName(id)))
+		}
+		conditions = append(conditions, i18n.T(lang, "route.cond_mode", strings.Join(names, "/")))
+	}
+	if len(rule.LobbyTypes) > 0 {
+		names := make([]string, 0, len(rule.LobbyTypes))
+		for _, id := range rule.LobbyTypes {
+			names = append(names, dota.LobbyTypeDisplayName(b.dotaClient.GetLobbyTypeName(id)))
+		}
+		conditions = append(conditions, i18n.T(lang, "route.cond_lobby", strings.Join(names, "/")))
+	}
+	switch rule.Result {
+	case notifyResultWin:
+		conditions = append(conditions, i18n.T(lang, "route.cond_win"))
+	case notifyResultLoss:
+		conditions = append(conditions, i18n.T(lang, "route.cond_loss"))
+	}
+	if rule.Player != "" {
+		conditions = append(conditions, i18n.T(lang, "route.cond_player", rule.Player))
+	}
+	switch rule.Highlight {
+	case "":
+	case routeAnyHighlight:
+		conditions = append(conditions, i18n.T(lang, "route.cond_any_highlight"))
+	default:
+		conditions = append(conditions, i18n.T(lang, "route.cond_highlight", rule.Highlight))
+	}
+	return fmt.Sprintf("%s → <#%s>", strings.Join(conditions, " · "), rule.ChannelID)
+}
+
+// routeMatches indica si la partida cumple todas las condiciones de la regla.
+// won es el resultado para el jugador discordID y highlights sus reglas de partida destacada cumplidas.
+func routeMatches(rule storage.RouteRule, match *dota.MatchResponse, won bool, discordID string, highlights []dota.Highlight) bool {
+	if len(rule.GameModes) > 0 && !containsInt(rule.GameModes, match.GameMode) {
+		return false
+	}
+	if len(rule.LobbyTypes) > 0 && !containsInt(rule.LobbyTypes, match.LobbyType) {
+		return false
+	}
+	if (rule.Result == notifyResultWin && !won) || (rule.Result == notifyResultLoss && won) {
+		return false
+	}
+	if rule.Player != "" && rule.Player != discordID {
+		return false
+	}
+	switch rule.Highlight {
+	case "":
+		return true
+	case routeAnyHighlight:
+		return len(highlights) > 0
+	default:
+		return dota.HasHighlight(highlights, dota.HighlightTag(rule.Highlight))
+	}
+}
+
+// routeChannel devuelve el canal de la primera regla del servidor de defaultChannelID que coincide con la partida
+// (defaultChannelID si ninguna coincide).
+func (b *Bot) routeChannel(defaultChannelID string, match *dota.MatchResponse, won bool, discordID string, highlights []dota.Highlight) string {
+	guildID := b.channelGuild(defaultChannelID)
+	if guildID == "" {
+		return defaultChannelID
+	}
+	for _, rule := range b.userStore.GetRoutes(guildID) {
+		if routeMatches(rule, match, won, discordID, highlights) {
+			return rule.ChannelID
+		}
+	}
+	return defaultChannelID
+}
+
+func containsInt(values []int, value int) bool {
+	for _, v := range values {
+		if v == value {
+			return true
+		}
+	}
+	return false
+}
@@ -0,0 +1,46 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/storage"
+	"testing"
+)
+
+func TestRouteMatches(t *testing.T) {
+	ranked := &dota.MatchResponse{MatchID: 1, GameMode: 22, LobbyType: dota.LobbyTypeRanked}
+	normal := &dota.MatchResponse{MatchID: 2, GameMode: dota.GameModeAllPick, LobbyType: dota.LobbyTypeNormal}
+	turbo := &dota.MatchResponse{MatchID: 3, GameMode: dota.GameModeTurbo, LobbyType: dota.LobbyTypeNormal}
+	rampage := []dota.Highlight{{Tag: dota.HighlightTags[0]}}
+
+	rankedRule := storage.RouteRule{LobbyTypes: []int{dota.LobbyTypeRanked}, ChannelID: "ranked"}
+	normalRule := storage.RouteRule{LobbyTypes: []int{dota.LobbyTypeNormal}, ChannelID: "normal"}
+	turboRule := storage.RouteRule{GameModes: []int{dota.GameModeTurbo}, ChannelID: "turbo"}
+
+	tests := []struct {
+		name       string
+		rule       storage.RouteRule
+		match      *dota.MatchResponse
+		won        bool
+		discordID  string
+		highlights []dota.Highlight
+		want       bool
+	}{
+		{"ranked en regla ranked", rankedRule, ranked, true, "1", nil, true},
+		{"normal en regla ranked", rankedRule, normal, true, "1", nil, false},
+		{"ranked en regla normal", normalRule, ranked, true, "1", nil, false},
+		{"normal en regla normal", normalRule, normal, true, "1", nil, true},
+		{"turbo en regla turbo", turboRule, turbo, false, "1", nil, true},
+		{"ranked en regla turbo", turboRule, ranked, true, "1", nil, false},
+		{"victoria en regla de derrotas", storage.RouteRule{Result: notifyResultLoss}, ranked, true, "1", nil, false},
+		{"derrota en regla de derrotas", storage.RouteRule{Result: notifyResultLoss}, turbo, false, "1", nil, true},
+		{"otro jugador", storage.RouteRule{LobbyTypes: []int{dota.LobbyTypeRanked}, Player: "2"}, ranked, true, "1", nil, false},
+		{"destacada cualquiera sin destacar", storage.RouteRule{Highlight: routeAnyHighlight}, ranked, true, "1", nil, false},
+		{"destacada cualquiera", storage.RouteRule{Highlight: routeAnyHighlight}, ranked, true, "1", rampage, true},
+		{"destacada concreta", storage.RouteRule{LobbyTypes: []int{dota.LobbyTypeRanked}, Highlight: string(dota.HighlightTags[0])}, ranked, true, "1", rampage, true},
+	}
+	for _, tt := range tests {
+		if got := routeMatches(tt.rule, tt.match, tt.won, tt.discordID, tt.highlights); got != tt.want {
+			t.Errorf("%s: routeMatches = %v, se esperaba %v", tt.name, got, tt.want)
+		}
+	}
+}
@@ -0,0 +1,105 @@
+package discord
+
+import (
+	"bytes"
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"dota-discord-bot/render"
+	"os"
+	"sort"
+	"strconv"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+const (
+	// scoreboardFile es el nombre del adjunto con el scoreboard de 10 jugadores (referenciado como attachment://).
+	scoreboardFile = "scoreboard.png"
+	// heroIconsDir es el directorio de miniaturas generado por cmd/download_hero_images.
+	heroIconsDir = "dota/miniaturas"
+)
+
+// scoreboardAvailable indica si hay miniaturas locales para dibujar el scoreboard; sin ellas se usa la lista de texto.
+func scoreboardAvailable() bool {
+	info, err := os.Stat(heroIconsDir)
+	return err == nil && info.IsDir()
+}
+
+// matchAttachments añade a embed la gráfica de ventaja y, si hay miniaturas locales, un segundo embed con el scoreboard,
+// con los textos en lang. Devuelve los embeds y archivos a enviar en el mismo mensaje.
+func (b *Bot) matchAttachments(lang i18n.Lang, embed *discordgo.MessageEmbed, match *dota.MatchResponse, radiant bool, upLabel string) ([]*discordgo.MessageEmbed, []*discordgo.File) {
+	embeds := []*discordgo.MessageEmbed{embed}
+	files := attachAdvantageGraph(lang, embed, match, radiant, upLabel)
+	if scoreboardEmbed, file := b.buildScoreboardAttachment(lang, match, embed.Color); file != nil {
+		embeds = append(embeds, scoreboardEmbed)
+		files = append(files, file)
+	}
+	return embeds, files
+}
+
+// buildScoreboardAttachment dibuja el scoreboard de la partida (jugadores registrados resaltados) y devuelve
+// el embed que lo muestra y el adjunto. Devuelve nil si no hay miniaturas o falla el render.
+func (b *Bot) buildScoreboardAttachment(lang i18n.Lang, match *dota.MatchResponse, color int) (*discordgo.MessageEmbed, *discordgo.File) {
+	if match == nil || len(match.Players) == 0 || !scoreboardAvailable() {
+		return nil, nil
+	}
+	registered := make(map[int]bool)
+	for _, accountID := range b.userStore.GetAll() {
+		if id, err := strconv.Atoi(accountID); err == nil {
+			registered[id] = true
+		}
+	}
+
+	players := make([]dota.Player, len(match.Players))
+	copy(players, match.Players)
+	sort.SliceStable(players, func(a, c int) bool { return players[a].PlayerSlot < players[c].PlayerSlot })
+
+	sb := render.Scoreboard{
+		RadiantScore: match.RadiantScore,
+		DireScore:    match.DireScore,
+		Lang:         lang,
+	}
+	if match.RadiantWin != nil {
+		sb.RadiantWin = *match.RadiantWin
+	}
+	for _, p := range players {
+		name := p.Personaname
+		if name == "" {
+			name = i18n.T(lang, "scoreboard.anonymous")
+			if p.AccountID != 0 {
+				name = i18n.T(lang, "scoreboard.player_id", p.AccountID)
+			}
+		}
+		row := render.ScoreboardRow{
+			HeroIcon:   render.LoadHeroIcon(heroIconsDir, b.dotaClient.GetHeroSlug(p.HeroID)),
+			HeroName:   b.dotaClient.GetHeroName(p.HeroID),
+			PlayerName: name,
+			Kills:      p.Kills,
+			Deaths:     p.Deaths,
+			Assists:    p.Assists,
+			GPM:        p.GoldPerMin,
+			HeroDamage: p.HeroDamage,
+			Highlight:  p.AccountID != 0 && registered[p.AccountID],
+		}
+		if p.PlayerSlot < 128 {
+			sb.Radiant = append(sb.Radiant, row)
+		} else {
+			sb.Dire = append(sb.Dire, row)
+		}
+	}
+
+	data, err := render.ScoreboardPNG(sb)
+	if err != nil {
+		getLogger().Warnf("Scoreboard para partida %d: %v", match.MatchID, err)
+		return nil, nil
+	}
+	embed := &discordgo.MessageEmbed{
+		Color: color,
+		Image: &discordgo.MessageEmbedImage{URL: "attachment://" + scoreboardFile},
+	}
+	return embed, &discordgo.File{
+		Name:        scoreboardFile,
+		ContentType: "image/png",
+		Reader:      bytes.NewReader(data),
+	}
+}
@@ -0,0 +1,203 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// sessionMaxAge: las sesiones que terminaron hace más se marcan como resumidas sin publicar nada
+// (p. ej. la primera vez que arranca el bot o tras estar caído)
+const sessionMaxAge = 12 * time.Hour
+
+// sessionFetchMatches es cuántas partidas recientes se piden para reconstruir la sesión de un jugador
+const sessionFetchMatches = 30
+
+// playerSession es la sesión terminada de un registrado
+type playerSession struct {
+	discordID string
+	accountID string
+	summary   dota.SessionSummary
+}
+
+// checkSessions publica el resumen de las sesiones que terminaron: sin partidas nuevas en SESSION_IDLE minutos.
+// latest es la partida más reciente de cada registrado (discord_id -> partida) vista en este ciclo del poller.
+// Los registrados que jugaron juntos (comparten alguna partida) se resumen en un único mensaje de party.
+func (b *Bot) checkSessions(channelID string, users map[string]string, latest map[string]dota.StratzMatch) {
+	idle := time.Duration(b.cfg().SessionIdleMinutes) * time.Minute
+	if idle == 0 {
+		return
+	}
+	now := time.Now()
+	// En horas de silencio las partidas van al resumen de /dota quiet; la sesión se resume al terminar la ventana
+	if b.isQuiet(b.channelGuild(channelID), now) {
+		return
+	}
+
+	var ended []playerSession
+	for discordID, match := range latest {
+		if last, ok := b.userStore.GetLastSession(discordID); ok && last == match.ID {
+			continue
+		}
+		sinceEnd := now.Sub(time.Unix(dota.MatchEnd(&match), 0))
+		if sinceEnd < idle {
+			continue
+		}
+		if sinceEnd > sessionMaxAge || b.userStore.GetNotifyPrefs(discordID).Disabled {
+			if err := b.userStore.SetLastSession(discordID, match.ID); err != nil {
+				getLogger().Errorf("Error guardando sesión de %s: %v", discordID, err)
+			}
+			continue
+		}
+
+		accountID := users[discordID]
+		accountIDInt, err := strconv.ParseInt(accountID, 10, 64)
+		if err != nil {
+			continue
+		}
+		matches, err := b.recentMatches(accountIDInt, sessionFetchMatches)
+		if err != nil {
+			getLogger().Errorf("Sesión: GetPlayerRecentMatches para %s: %v", accountID, err)
+			continue
+		}
+		session := dota.LatestSession(matches)
+		if len(session) == 0 || session[0].ID != match.ID {
+			continue // apareció una partida nueva entre medias: se revisa en el siguiente ciclo
+		}
+		summary := dota.SummarizeSession(session, accountIDInt)
+		if len(summary.MatchIDs) == 0 {
+			// El jugador no aparece en ninguna partida de la sesión (cuenta anónima o datos parciales): nada que resumir
+			if err := b.userStore.SetLastSession(discordID, match.ID); err != nil {
+				getLogger().Errorf("Error guardando sesión de %s: %v", discordID, err)
+			}
+			continue
+		}
+		ended = append(ended, playerSession{discordID: discordID, accountID: accountID, summary: summary})
+	}
+
+	lang := b.channelLang(channelID)
+	for _, party := range groupSessions(ended) {
+		if _, err := b.session.ChannelMessageSendEmbed(channelID, b.buildSessionEmbed(lang, party)); err != nil {
+			getLogger().Errorf("Error enviando resumen de sesión: %v", err)
+			continue
+		}
+		for _, p := range party {
+			getLogger().Infof("Resumen de sesión de %s publicado (%d partidas)", p.accountID, p.summary.Summary.Games)
+			if err := b.userStore.SetLastSession(p.discordID, p.summary.MatchIDs[0]); err != nil {
+				getLogger().Errorf("Error guardando sesión de %s: %v", p.discordID, err)
+			}
+		}
+	}
+}
+
+// groupSessions agrupa en parties las sesiones que comparten alguna partida, ordenadas por inicio de la sesión.
+func groupSessions(sessions []playerSession) [][]playerSession {
+	sort.Slice(sessions, func(i, j int) bool {
+		if sessions[i].summary.Start != sessions[j].summary.Start {
+			return sessions[i].summary.Start < sessions[j].summary.Start
+		}
+		return sessions[i].discordID < sessions[j].discordID
+	})
+	var parties [][]playerSession
+	partyOf := make(map[int64]int) // match_id -> índice de la party
+	for _, s := range sessions {
+		idx := -1
+		for _, id := range s.summary.MatchIDs {
+			if found, ok := partyOf[id]; ok {
+				idx = found
+				break
+			}
+		}
+		if idx < 0 {
+			idx = len(parties)
+			parties = append(parties, nil)
+		}
+		parties[idx] = append(parties[idx], s)
+		for _, id := range s.summary.MatchIDs {
+			partyOf[id] = idx
+		}
+	}
+	return parties
+}
+
+// buildSessionEmbed resume la sesión de una party (o de un jugador): partidas, tiempo jugado y, por jugador,
+// W/L, cambio de rango neto y héroes; el MVP de la noche es la partida con mayor puntuación de rendimiento.
+func (b *Bot) buildSessionEmbed(lang i18n.Lang, party []playerSession) *discordgo.MessageEmbed {
+	matchIDs := make(map[int64]bool)
+	var start, end int64
+	duration := 0
+	mvp := party[0]
+	for _, p := range party {
+		for _, id := range p.summary.MatchIDs {
+			matchIDs[id] = true
+		}
+		if start == 0 || p.summary.Start < start {
+			start = p.summary.Start
+		}
+		end = max(end, p.summary.End)
+		duration = max(duration, p.summary.Duration) // la party comparte partidas: cuenta el que más jugó
+		if p.summary.BestScore > mvp.summary.BestScore {
+			mvp = p
+		}
+	}
+
+	title := i18n.T(lang, "session.title")
+	if len(party) > 1 {
+		title = i18n.T(lang, "session.title_party")
+	}
+	embed := &discordgo.MessageEmbed{
+		Title:       title,
+		Description: i18n.T(lang, "session.description", len(matchIDs), dota.FormatDuration(duration), start, end),
+		Color:       0x1abc9c,
+		Timestamp:   time.Now().Format(time.RFC3339),
+	}
+	for _, p := range party {
+		summary := p.summary.Summary
+		var heroes []string
+		for _, hero := range summary.TopHeroes(0) {
+			name := b.dotaClient.GetHeroName(hero.HeroID)
+			if hero.Games > 1 {
+				name = fmt.Sprintf("%s ×%d", name, hero.Games)
+			}
+			heroes = append(heroes, name)
+		}
+		name, _ := b.getPlayerNameAndAvatar(p.accountID, summary.SteamAccountID)
+		if name == "" {
+			name = i18n.T(lang, "player.default")
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+			Name: name,
+			Value: i18n.T(lang, "session.player", p.discordID, summary.Games, summary.Wins, summary.Losses(), dota.FormatDuration(p.summary.Duration)) + "\n" +
+				i18n.T(lang, "session.rank", b.sessionRankChange(lang, p.accountID, p.summary.Start)) + "\n" +
+				i18n.T(lang, "session.heroes", strings.Join(heroes, ", ")),
+		})
+	}
+	embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{
+		Name:  i18n.T(lang, "session.mvp"),
+		Value: fmt.Sprintf("%s · %.0f/100", b.formatGameHighlight(lang, mvp.summary.Best, "<@"+mvp.discordID+">"), mvp.summary.BestScore),
+	})
+	return embed
+}
+
+// sessionRankChange devuelve el cambio de rango neto desde el inicio de la sesión según el historial de rangos.
+func (b *Bot) sessionRankChange(lang i18n.Lang, accountID string, since int64) string {
+	start, latest, ok := rankChangeSince(b.userStore.GetRankHistory(accountID), time.Unix(since, 0))
+	if !ok {
+		return "—"
+	}
+	delta := dota.RankSteps(latest.RankBracket, latest.Rank) - dota.RankSteps(start.RankBracket, start.Rank)
+	switch {
+	case delta > 0:
+		return fmt.Sprintf("%s → %s 📈", formatRank(lang, start.RankBracket, start.Rank), formatRank(lang, latest.RankBracket, latest.Rank))
+	case delta < 0:
+		return fmt.Sprintf("%s → %s 📉", formatRank(lang, start.RankBracket, start.Rank), formatRank(lang, latest.RankBracket, latest.Rank))
+	default:
+		return i18n.T(lang, "session.rank_same", formatRank(lang, latest.RankBracket, latest.Rank))
+	}
+}
@@ -0,0 +1,134 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"fmt"
+	"strings"
+	"time"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// statsDateLayout es el formato de las opciones desde/hasta de /dota stats.
+const statsDateLayout = "2006-01-02"
+
+// statsFilterOptions son las opciones de filtro de /dota stats (se registran en registerCommands).
+var statsFilterOptions = []*discordgo.ApplicationCommandOption{
+	{
+		Type:        discordgo.ApplicationCommandOptionString,
+		Name:        "rol",
+		Description: "Filtrar por rol",
+		Required:    false,
+		Choices: []*discordgo.ApplicationCommandOptionChoice{
+			{Name: "Core", Value: "core"},
+			{Name: "Support", Value: "support"},
+		},
+	},
+	{
+		Type:        discordgo.ApplicationCommandOptionString,
+		Name:        "linea",
+		Description: "Filtrar por línea",
+		Required:    false,
+		Choices: []*discordgo.ApplicationCommandOptionChoice{
+			{Name: "Safe lane", Value: "safe"},
+			{Name: "Mid", Value: "mid"},
+			{Name: "Off lane", Value: "off"},
+		},
+	},
+	{
+		Type:        discordgo.ApplicationCommandOptionString,
+		Name:        "modo",
+		Description: "Filtrar por modo de juego",
+		Required:    false,
+		Choices: []*discordgo.ApplicationCommandOptionChoice{
+			{Name: "Ranked", Value: "ranked"},
+			{Name: "Turbo", Value: "turbo"},
+			{Name: "All Pick", Value: "allpick"},
+		},
+	},
+	{
+		Type:        discordgo.ApplicationCommandOptionString,
+		Name:        "desde",
+		Description: "Desde la fecha (YYYY-MM-DD)",
+		Required:    false,
+	},
+	{
+		Type:        discordgo.ApplicationCommandOptionString,
+		Name:        "hasta",
+		Description: "Hasta la fecha, inclusive (YYYY-MM-DD)",
+		Required:    false,
+	},
+	{
+		Type:        discordgo.ApplicationCommandOptionBoolean,
+		Name:        "parche",
+		Description: fmt.Sprintf("Solo el parche actual (últimos %d días)", dota.StatsPatchDays()),
+		Required:    false,
+	},
+}
+
+// parseStatsFilter convierte las opciones rol/linea/modo/desde/hasta/parche en un filtro de Stratz.
+// Devuelve también el texto para el footer del embed ("" si no hay filtros) o un mensaje de error para el usuario, ambos en lang.
+func parseStatsFilter(lang i18n.Lang, options []*discordgo.ApplicationCommandInteractionDataOption) (filter dota.MatchFilter, filterText, errMsg string) {
+	var labels []string
+	for _, option := range options {
+		switch option.Name {
+		case "rol":
+			switch option.StringValue() {
+			case "core":
+				filter.RoleIDs = []int{dota.StratzRoleCore}
+				labels = append(labels, i18n.T(lang, "filter.role", "Core"))
+			case "support":
+				filter.RoleIDs = []int{dota.StratzRoleLightSupport, dota.StratzRoleHardSupport}
+				labels = append(labels, i18n.T(lang, "filter.role", "Support"))
+			}
+		case "linea":
+			switch option.StringValue() {
+			case "safe":
+				filter.LaneIDs = []int{dota.StratzLaneSafe}
+				labels = append(labels, i18n.T(lang, "filter.lane", "Safe"))
+			case "mid":
+				filter.LaneIDs = []int{dota.StratzLaneMid}
+				labels = append(labels, i18n.T(lang, "filter.lane", "Mid"))
+			case "off":
+				filter.LaneIDs = []int{dota.StratzLaneOff}
+				labels = append(labels, i18n.T(lang, "filter.lane", "Off"))
+			}
+		case "modo":
+			switch option.StringValue() {
+			case "ranked":
+				filter.LobbyTypeIDs = []int{dota.LobbyTypeRanked}
+				labels = append(labels, i18n.T(lang, "filter.mode", "Ranked"))
+			case "turbo":
+				filter.GameModeIDs = []int{dota.GameModeTurbo}
+				labels = append(labels, i18n.T(lang, "filter.mode", "Turbo"))
+			case "allpick":
+				filter.GameModeIDs = []int{dota.GameModeAllPick, dota.GameModeAllDraft}
+				labels = append(labels, i18n.T(lang, "filter.mode", "All Pick"))
+			}
+		case "desde":
+			t, err := time.ParseInLocation(statsDateLayout, option.StringValue(), time.Local)
+			if err != nil {
+				return filter, "", i18n.T(lang, "filter.invalid_date", optionName(lang, "desde"), option.StringValue())
+			}
+			filter.StartDateTime = t.Unix()
+			labels = append(labels, i18n.T(lang, "filter.from", option.StringValue()))
+		case "hasta":
+			t, err := time.ParseInLocation(statsDateLayout, option.StringValue(), time.Local)
+			if err != nil {
+				return filter, "", i18n.T(lang, "filter.invalid_date", optionName(lang, "hasta"), option.StringValue())
+			}
+			filter.EndDateTime = t.AddDate(0, 0, 1).Unix() - 1 // inclusive: hasta el final del día
+			labels = append(labels, i18n.T(lang, "filter.to", option.StringValue()))
+		case "parche":
+			if option.BoolValue() && filter.StartDateTime == 0 {
+				filter.StartDateTime = dota.PatchFilter().StartDateTime
+				labels = append(labels, i18n.T(lang, "filter.patch", dota.StatsPatchDays()))
+			}
+		}
+	}
+	if filter.StartDateTime > 0 && filter.EndDateTime > 0 && filter.StartDateTime > filter.EndDateTime {
+		return filter, "", i18n.T(lang, "filter.bad_range")
+	}
+	return filter, strings.Join(labels, " • "), ""
+}
@@ -0,0 +1,157 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"fmt"
+	"strconv"
+	"strings"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// synergyMaxRows es el máximo de dúos/stacks listados en el embed de sinergia.
+const synergyMaxRows = 10
+
+// fetchRegisteredHistories obtiene las últimas take partidas de cada registrado.
+// Devuelve historiales por account_id y el mapa account_id -> discord ID para mencionar a los jugadores.
+func (b *Bot) fetchRegisteredHistories(take int) (map[int64][]dota.StratzMatch, map[int64]string) {
+	histories := make(map[int64][]dota.StratzMatch)
+	discordIDs := make(map[int64]string)
+	for discordID, accountID := range b.userStore.GetAll() {
+		accountIDInt, errParse := strconv.ParseInt(accountID, 10, 64)
+		if errParse != nil {
+			getLogger().Debugf("account_id inválido omitido: %s", accountID)
+			continue
+		}
+		matches, err := b.stratzClient.GetPlayerRecentMatches(accountIDInt, take)
+		if err != nil {
+			getLogger().Errorf("GetPlayerRecentMatches para %s: %v", accountID, err)
+			continue
+		}
+		histories[accountIDInt] = matches
+		discordIDs[accountIDInt] = discordID
+	}
+	return histories, discordIDs
+}
+
+// handleSynergySlash muestra la sinergia de dúos y stacks entre registrados: /dota synergy [usuario:@usuario].
+func (b *Bot) handleSynergySlash(s *discordgo.Session, i *discordgo.InteractionCreate, subcommand *discordgo.ApplicationCommandInteractionDataOption) {
+	var targetUser *discordgo.User
+	for _, option := range subcommand.Options {
+		if option.Name == "usuario" {
+			targetUser = option.UserValue(s)
+		}
+	}
+
+	if b.stratzClient == nil || !b.stratzClient.IsConfigured() {
+		b.sendFollowup(s, i, b.t(i, "err.stratz_not_configured"))
+		return
+	}
+
+	lang := b.lang(i)
+	var targetAccount int64
+	if targetUser != nil {
+		account, errMsg := b.registeredAccount(lang, targetUser)
+		if errMsg != "" {
+			b.sendFollowup(s, i, errMsg)
+			return
+		}
+		targetAccount = account
+	}
+
+	if len(b.userStore.GetAll()) < 2 {
+		b.sendFollowup(s, i, i18n.T(lang, "synergy.need_two"))
+		return
+	}
+
+	take := b.cfg().StatsTake
+	histories, discordIDs := b.fetchRegisteredHistories(take)
+	report := dota.ComputeSynergy(histories)
+	title := i18n.T(lang, "synergy.title_group")
+	if targetUser != nil {
+		report = report.FilterByAccount(targetAccount)
+		title = i18n.T(lang, "synergy.title_player", targetUser.Username)
+	}
+
+	if len(report.Pairs) == 0 {
+		b.sendFollowup(s, i, i18n.T(lang, "synergy.none", take))
+		return
+	}
+
+	embed := &discordgo.MessageEmbed{
+		Title:  title,
+		Color:  0x1abc9c,
+		Fields: b.buildSynergyFields(lang, report, discordIDs, b.cfg().StatsMinGames),
+		Footer: &discordgo.MessageEmbedFooter{
+			Text: i18n.T(lang, "synergy.footer", take, b.cfg().StatsMinGames),
+		},
+	}
+	if report.Matches > 0 {
+		embed.Description = i18n.T(lang, "synergy.description", report.Matches)
+	}
+	b.sendFollowupEmbed(s, i, embed)
+}
+
+// buildSynergyFields construye los campos de mejor/peor dúo, dúos y stacks en lang (reutilizado en el recap semanal).
+func (b *Bot) buildSynergyFields(lang i18n.Lang, report dota.SynergyReport, discordIDs map[int64]string, minGames int) []*discordgo.MessageEmbedField {
+	var fields []*discordgo.MessageEmbedField
+	if best, ok := report.BestPair(minGames); ok {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   i18n.T(lang, "synergy.best"),
+			Value:  formatPartyRecord(best, discordIDs),
+			Inline: true,
+		})
+	}
+	if worst, ok := report.WorstPair(minGames); ok {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   i18n.T(lang, "synergy.worst"),
+			Value:  formatPartyRecord(worst, discordIDs),
+			Inline: true,
+		})
+	}
+
+	var pairLines []string
+	for idx, p := range report.Pairs {
+		if idx >= synergyMaxRows {
+			break
+		}
+		pairLines = append(pairLines, formatPartyRecord(p, discordIDs))
+	}
+	if len(pairLines) > 0 {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   i18n.T(lang, "synergy.pairs"),
+			Value:  joinFieldLines(pairLines),
+			Inline: false,
+		})
+	}
+
+	var stackLines []string
+	for idx, st := range report.Stacks {
+		if idx >= synergyMaxRows {
+			break
+		}
+		stackLines = append(stackLines, formatPartyRecord(st, discordIDs))
+	}
+	if len(stackLines) > 0 {
+		fields = append(fields, &discordgo.MessageEmbedField{
+			Name:   "Stacks (3+)",
+			Value:  joinFieldLines(stackLines),
+			Inline: false,
+		})
+	}
+	return fields
+}
+
+// formatPartyRecord devuelve "<@a> + <@b> — 7-3 (70.0%)".
+func formatPartyRecord(r dota.PartyRecord, discordIDs map[int64]string) string {
+	names := make([]string, 0, len(r.AccountIDs))
+	for _, id := range r.AccountIDs {
+		if discordID, ok := discordIDs[id]; ok {
+			names = append(names, fmt.Sprintf("<@%s>", discordID))
+		} else {
+			names = append(names, strconv.FormatInt(id, 10))
+		}
+	}
+	return fmt.Sprintf("%s — %d-%d (%.1f%%)", strings.Join(names, " + "), r.Wins, r.Losses(), r.WinRate())
+}
@@ -0,0 +1,223 @@
+package discord
+
+import (
+	"bytes"
+	"dota-discord-bot/storage"
+	"encoding/json"
+	"strings"
+	"sync"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// Custom IDs de los botones de la vista previa de plantilla. La plantilla pendiente se guarda en memoria
+// por usuario (no cabe en el custom_id), así que una vista previa no sobrevive a un reinicio.
+const (
+	templateSaveCustomID   = "template_save"
+	templateCancelCustomID = "template_cancel"
+)
+
+// templateFile es el nombre del adjunto con el JSON de la plantilla actual
+const templateFile = "plantilla.json"
+
+// pendingTemplate es una plantilla previsualizada que espera confirmación
+type pendingTemplate struct {
+	guildID  string
+	template storage.NotificationTemplate
+}
+
+// pendingTemplates guarda la última vista previa de cada usuario (discord_id -> plantilla)
+type pendingTemplates struct {
+	mu    sync.Mutex
+	items map[string]pendingTemplate
+}
+
+func (p *pendingTemplates) put(userID string, pending pendingTemplate) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	if p.items == nil {
+		p.items = make(map[string]pendingTemplate)
+	}
+	p.items[userID] = pending
+}
+
+func (p *pendingTemplates) take(userID string) (pendingTemplate, bool) {
+	p.mu.Lock()
+	defer p.mu.Unlock()
+	pending, ok := p.items[userID]
+	delete(p.items, userID)
+	return pending, ok
+}
+
+// handleTemplateSlash gestiona la plantilla de notificaciones del servidor: /dota template show|preset|edit|reset.
+// preset y edit muestran una vista previa con una partida de ejemplo y solo guardan al pulsar Guardar.
+func (b *Bot) handleTemplateSlash(s *discordgo.Session, i *discordgo.InteractionCreate, group *discordgo.ApplicationCommandInteractionDataOption) {
+	if len(group.Options) == 0 {
+		b.sendFollowup(s, i, b.t(i, "err.invalid_command"))
+		return
+	}
+	if i.GuildID == "" {
+		b.sendFollowup(s, i, b.t(i, "template.guild_only"))
+		return
+	}
+	action := group.Options[0]
+	if action.Name != "show" && !hasManageServer(i) {
+		b.sendFollowup(s, i, b.t(i, "err.need_manage"))
+		return
+	}
+
+	switch action.Name {
+	case "show":
+		b.showTemplate(s, i)
+	case "preset":
+		name := ""
+		for _, option := range action.Options {
+			if option.Name == "nombre" {
+				name = option.StringValue()
+			}
+		}
+		if _, ok := templatePresets[name]; !ok {
+			b.sendFollowup(s, i, b.t(i, "template.unknown_preset", name, strings.Join(templatePresetNames, ", ")))
+			return
+		}
+		b.previewTemplate(s, i, storage.NotificationTemplate{Preset: name})
+	case "edit":
+		raw := ""
+		for _, option := range action.Options {
+			if option.Name == "json" {
+				raw = option.StringValue()
+			}
+		}
+		var tpl storage.NotificationTemplate
+		decoder := json.NewDecoder(strings.NewReader(raw))
+		decoder.DisallowUnknownFields()
+		if err := decoder.Decode(&tpl); err != nil {
+			b.sendFollowup(s, i, b.t(i, "template.invalid_json", err))
+			return
+		}
+		b.previewTemplate(s, i, tpl)
+	case "reset":
+		if err := b.userStore.SetGuildTemplate(i.GuildID, nil); err != nil {
+			getLogger().Errorf("Error restableciendo plantilla: %v", err)
+			b.sendFollowup(s, i, b.t(i, "template.save_error"))
+			return
+		}
+		getLogger().Infof("Plantilla de notificación restablecida en %s", i.GuildID)
+		b.sendFollowup(s, i, b.t(i, "template.reset"))
+	default:
+		b.sendFollowup(s, i, b.t(i, "err.unknown_command"))
+	}
+}
+
+// showTemplate muestra la plantilla actual del servidor, su vista previa y su JSON adjunto (para copiarlo y editarlo).
+func (b *Bot) showTemplate(s *discordgo.Session, i *discordgo.InteractionCreate) {
+	tpl, ok := b.userStore.GetGuildTemplate(i.GuildID)
+	if !ok {
+		b.sendFollowup(s, i, b.t(i, "template.current_default", strings.Join(templatePresetNames, ", ")))
+		return
+	}
+	content := b.t(i, "template.current_custom")
+	if tpl.Preset != "" {
+		content = b.t(i, "template.current_preset", tpl.Preset)
+	}
+	params := &discordgo.WebhookParams{Content: content}
+	if definition, err := resolveTemplate(tpl); err == nil {
+		if data, err := templateJSON(definition); err == nil {
+			params.Files = []*discordgo.File{{Name: templateFile, ContentType: "application/json", Reader: bytes.NewReader(data)}}
+		}
+	}
+	embed, err := renderTemplate(b.lang(i), tpl, b.sampleMatchView(b.lang(i)))
+	if err != nil {
+		params.Content += "\n" + b.t(i, "template.invalid", err)
+	} else {
+		params.Embeds = []*discordgo.MessageEmbed{embed}
+	}
+	_, errSend := s.FollowupMessageCreate(i.Interaction, true, params)
+	if errSend != nil {
+		getLogger().Errorf("Error enviando plantilla: %v", errSend)
+	}
+}
+
+// previewTemplate valida tpl renderizándolo con una partida de ejemplo y, si es válido, lo muestra con botones Guardar/Cancelar.
+func (b *Bot) previewTemplate(s *discordgo.Session, i *discordgo.InteractionCreate, tpl storage.NotificationTemplate) {
+	lang := b.lang(i)
+	embed, err := renderTemplate(lang, tpl, b.sampleMatchView(lang))
+	if err != nil {
+		b.sendFollowup(s, i, b.t(i, "template.invalid", err))
+		return
+	}
+	user := interactionUser(i)
+	if user == nil {
+		b.sendFollowup(s, i, b.t(i, "err.user_unknown"))
+		return
+	}
+	b.pendingTemplates.put(user.ID, pendingTemplate{guildID: i.GuildID, template: tpl})
+
+	_, errSend := s.FollowupMessageCreate(i.Interaction, true, &discordgo.WebhookParams{
+		Content: b.t(i, "template.preview"),
+		Embeds:  []*discordgo.MessageEmbed{embed},
+		Components: []discordgo.MessageComponent{
+			discordgo.ActionsRow{Components: []discordgo.MessageComponent{
+				discordgo.Button{Label: b.t(i, "template.save"), Style: discordgo.SuccessButton, CustomID: templateSaveCustomID},
+				discordgo.Button{Label: b.t(i, "template.cancel"), Style: discordgo.SecondaryButton, CustomID: templateCancelCustomID},
+			}},
+		},
+	})
+	if errSend != nil {
+		getLogger().Errorf("Error enviando vista previa de plantilla: %v", errSend)
+	}
+}
+
+// handleTemplateComponent responde a los botones Guardar/Cancelar de la vista previa.
+func (b *Bot) handleTemplateComponent(s *discordgo.Session, i *discordgo.InteractionCreate, customID string) {
+	content := b.t(i, "template.expired")
+	if user := interactionUser(i); user != nil {
+		if pending, ok := b.pendingTemplates.take(user.ID); ok {
+			switch {
+			case customID == templateCancelCustomID:
+				content = b.t(i, "template.cancelled")
+			case !hasManageServer(i):
+				content = b.t(i, "err.need_manage")
+			default:
+				if err := b.userStore.SetGuildTemplate(pending.guildID, &pending.template); err != nil {
+					getLogger().Errorf("Error guardando plantilla: %v", err)
+					content = b.t(i, "template.save_error")
+				} else {
+					getLogger().Infof("Plantilla de notificación guardada en %s por %s", pending.guildID, user.Username)
+					content = b.t(i, "template.saved")
+				}
+			}
+		}
+	}
+
+	err := s.InteractionRespond(i.Interaction, &discordgo.InteractionResponse{
+		Type: discordgo.InteractionResponseUpdateMessage,
+		Data: &discordgo.InteractionResponseData{
+			Content:    content,
+			Components: []discordgo.MessageComponent{},
+		},
+	})
+	if err != nil {
+		getLogger().Errorf("Error respondiendo a componente de plantilla: %v", err)
+	}
+}
+
+// guildTemplate devuelve la plantilla de notificación del servidor del canal (false = formato por defecto)
+func (b *Bot) guildTemplate(channelID string) (storage.NotificationTemplate, bool) {
+	guildID := b.channelGuild(channelID)
+	if guildID == "" {
+		return storage.NotificationTemplate{}, false
+	}
+	return b.userStore.GetGuildTemplate(guildID)
+}
+
+// templateJSON devuelve la plantilla como JSON en una sola línea, lista para pegar en /dota template edit json:
+func templateJSON(tpl storage.NotificationTemplate) ([]byte, error) {
+	var out bytes.Buffer
+	encoder := json.NewEncoder(&out)
+	encoder.SetEscapeHTML(false)
+	if err := encoder.Encode(tpl); err != nil {
+		return nil, err
+	}
+	return bytes.TrimSpace(out.Bytes()), nil
+}
@@ -0,0 +1,455 @@
+package discord
+
+import (
+	"bytes"
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"dota-discord-bot/storage"
+	"fmt"
+	"strconv"
+	"strings"
+	"text/template"
+
+	"github.com/bwmarrin/discordgo"
+)
+
+// MatchView es la vista estable de una partida que reciben las plantillas de notificación ({{.Player.Hero}}, {{.Match.ID}}…).
+// Los textos ya vienen formateados en el idioma del servidor; renombrar campos rompe las plantillas guardadas.
+type MatchView struct {
+	Match      MatchInfoView
+	Player     PlayerView
+	Profile    ProfileView
+	Streak     StreakView
+	Lane       LaneView
+	Highlights []string // textos de partida destacada (ej. "🔥 22 kills")
+}
+
+// MatchInfoView son los datos de la partida
+type MatchInfoView struct {
+	ID           int64
+	URL          string // link a Stratz
+	Duration     string // 42:17
+	GameMode     string // ALL PICK, TURBO…
+	Ranked       bool
+	RadiantWin   bool
+	RadiantScore int
+	DireScore    int
+	MVP          string // "**Hero** — Jugador\n12/3/20 • 78 pts"
+	Worst        string
+	Scoring      string // explicación de la puntuación MVP
+}
+
+// PlayerView son los datos del jugador registrado en la partida
+type PlayerView struct {
+	Hero        string
+	HeroImage   string
+	HeroIcon    string
+	Win         bool
+	Result      string // ✅ Victoria / ❌ Derrota
+	Team        string // Radiant, Dire
+	Kills       int
+	Deaths      int
+	Assists     int
+	KDA         float64
+	GPM         int
+	XPM         int
+	Level       int
+	HeroDamage  int
+	TowerDamage int
+	HeroHealing int
+	NetWorth    int
+	Lane        string // SAFE_LANE, MID_LANE…
+	Role        string // CORE, SUPPORT…
+	HeroRecord  string // W/L con el héroe en las últimas 20 partidas
+	Items       string // inventario, mochila, neutral y timings
+}
+
+// ProfileView son los datos del perfil del jugador
+type ProfileView struct {
+	Name    string
+	Avatar  string
+	Bracket string // LEGEND, ANCIENT… (Stratz)
+	Rank    string // Legend 4 (vacío si no hay rango)
+	URL     string // perfil en Stratz
+}
+
+// StreakView es la racha actual del jugador (Count 0 si no hay datos)
+type StreakView struct {
+	Count int
+	Win   bool
+	Text  string // "3 victorias consecutivas 🔥"
+}
+
+// LaneView es el resultado de la fase de líneas
+type LaneView struct {
+	Phase   string // victoria/derrota del jugador en su línea (vacío si jungla/roaming)
+	Summary string // Top/Mid/Bottom con colores
+	Top     string
+	Mid     string
+	Bottom  string
+}
+
+// Presets de plantilla incorporados; full reproduce el embed por defecto.
+const (
+	templatePresetCompact = "compact"
+	templatePresetFull    = "full"
+	templatePresetMeme    = "meme"
+)
+
+// templatePresetNames son los presets en el orden en que se ofrecen
+var templatePresetNames = []string{templatePresetCompact, templatePresetFull, templatePresetMeme}
+
+var templatePresets = map[string]storage.NotificationTemplate{
+	templatePresetCompact: {
+		Title:       "{{if .Player.Win}}✅{{else}}❌{{end}} {{.Profile.Name}} — {{.Player.Hero}}",
+		Description: "{{.Player.Kills}}/{{.Player.Deaths}}/{{.Player.Assists}} · {{.Player.GPM}} GPM · {{.Match.Duration}} · {{.Match.GameMode}}",
+		Footer:      "{{with .Streak.Text}}{{.}} · {{end}}Match ID: {{.Match.ID}}",
+		Thumbnail:   "hero",
+	},
+	templatePresetFull: {
+		Title:       "{{.Profile.Name}}{{with .Profile.Bracket}} [{{.}}]{{end}} - {{.Player.Result}}",
+		Description: "**{{.Player.Hero}}** | {{.Match.GameMode}}{{with .Lane.Phase}}\n{{.}}{{end}}",
+		Fields: []storage.TemplateField{
+			{Name: "K/D/A", Value: `{{.Player.Kills}}/{{.Player.Deaths}}/{{.Player.Assists}} ({{printf "%.2f" .Player.KDA}} KDA)`, Inline: true},
+			{Name: `{{t "match.duration"}}`, Value: "{{.Match.Duration}}", Inline: true},
+			{Name: `{{t "match.level"}}`, Value: "{{.Player.Level}}", Inline: true},
+			{Name: "Score", Value: "Radiant {{.Match.RadiantScore}} - {{.Match.DireScore}} Dire", Inline: true},
+			{Name: "GPM/XPM", Value: "{{.Player.GPM}} / {{.Player.XPM}}", Inline: true},
+			{Name: `{{t "match.mode"}}`, Value: "{{.Match.GameMode}}", Inline: true},
+			{Name: `{{t "match.hero_record" .Player.Hero}}`, Value: "{{.Player.HeroRecord}}"},
+			{Name: `{{t "match.lane_role"}}`, Value: "{{.Player.Lane}}{{if and .Player.Lane .Player.Role}} / {{end}}{{.Player.Role}}", Inline: true},
+			{Name: `{{t "match.lane_results"}}`, Value: "{{.Lane.Summary}}"},
+			{Name: "Hero Damage", Value: "{{if .Player.HeroDamage}}{{.Player.HeroDamage}}{{end}}", Inline: true},
+			{Name: "Tower Damage", Value: "{{if .Player.TowerDamage}}{{.Player.TowerDamage}}{{end}}", Inline: true},
+			{Name: "Hero Healing", Value: "{{if .Player.HeroHealing}}{{.Player.HeroHealing}}{{end}}", Inline: true},
+			{Name: "Items", Value: "{{.Player.Items}}"},
+			{Name: `{{t "match.mvp"}}`, Value: "{{.Match.MVP}}", Inline: true},
+			{Name: `{{t "match.worst"}}`, Value: "{{.Match.Worst}}", Inline: true},
+			{Name: "Rank", Value: "{{.Profile.Rank}}", Inline: true},
+		},
+		Footer:      "{{with .Streak.Text}}{{.}} | {{end}}Match ID: {{.Match.ID}}\n{{.Match.Scoring}}",
+		Image:       "hero",
+		Thumbnail:   "avatar",
+		Attachments: true,
+	},
+	templatePresetMeme: {
+		Title: `{{if .Player.Win}}{{t "tpl.meme.win_title" .Profile.Name}}{{else}}{{t "tpl.meme.loss_title" .Profile.Name}}{{end}}`,
+		Description: `{{if ge .Player.Deaths 10}}{{t "tpl.meme.feeder" .Player.Deaths}}` +
+			`{{else if eq .Player.Deaths 0}}{{t "tpl.meme.deathless"}}` +
+			`{{else if .Player.Win}}{{t "tpl.meme.win" .Player.Hero}}` +
+			`{{else}}{{t "tpl.meme.loss" .Player.Hero}}{{end}}` +
+			"\n" + `{{t "tpl.meme.kda" .Player.Kills .Player.Deaths .Player.Assists .Match.Duration}}`,
+		Footer: "{{with .Streak.Text}}{{.}} · {{end}}Match ID: {{.Match.ID}}",
+		Image:  "hero",
+	},
+}
+
+// Límites de Discord para embeds
+const (
+	embedTitleMax       = 256
+	embedDescriptionMax = 4096
+	embedFieldsMax      = 25
+	embedFieldNameMax   = 256
+	embedFieldValueMax  = 1024
+	embedFooterMax      = 2048
+	embedTotalMax       = 6000
+)
+
+// resolveTemplate devuelve la definición de la plantilla (la del preset si tiene uno)
+func resolveTemplate(tpl storage.NotificationTemplate) (storage.NotificationTemplate, error) {
+	if tpl.Preset == "" {
+		return tpl, nil
+	}
+	preset, ok := templatePresets[tpl.Preset]
+	if !ok {
+		return storage.NotificationTemplate{}, fmt.Errorf("preset desconocido: %q", tpl.Preset)
+	}
+	return preset, nil
+}
+
+// templateAttachments indica si la plantilla adjunta la gráfica de ventaja y el scoreboard
+func templateAttachments(tpl storage.NotificationTemplate) bool {
+	definition, err := resolveTemplate(tpl)
+	return err == nil && definition.Attachments
+}
+
+// renderTemplate construye el embed de la notificación ejecutando la plantilla sobre view.
+// Falla si alguna parte no compila o no se puede ejecutar, o si el resultado excede los límites de Discord.
+func renderTemplate(lang i18n.Lang, tpl storage.NotificationTemplate, view MatchView) (*discordgo.MessageEmbed, error) {
+	tpl, err := resolveTemplate(tpl)
+	if err != nil {
+		return nil, err
+	}
+	funcs := template.FuncMap{
+		"t":     func(key string, args ...interface{}) string { return i18n.T(lang, key, args...) },
+		"upper": strings.ToUpper,
+		"lower": strings.ToLower,
+		"join":  strings.Join,
+	}
+	exec := func(part, text string, max int) (string, error) {
+		t, err := template.New(part).Funcs(funcs).Parse(text)
+		if err != nil {
+			return "", err
+		}
+		var out bytes.Buffer
+		if err := t.Execute(&out, view); err != nil {
+			return "", err
+		}
+		result := strings.TrimSpace(out.String())
+		if len([]rune(result)) > max {
+			return "", fmt.Errorf("%s: %d caracteres (máximo %d)", part, len([]rune(result)), max)
+		}
+		return result, nil
+	}
+
+	embed := &discordgo.MessageEmbed{URL: view.Match.URL}
+	if embed.Title, err = exec("title", tpl.Title, embedTitleMax); err != nil {
+		return nil, err
+	}
+	if embed.Description, err = exec("description", tpl.Description, embedDescriptionMax); err != nil {
+		return nil, err
+	}
+	if len(tpl.Fields) > embedFieldsMax {
+		return nil, fmt.Errorf("fields: %d campos (máximo %d)", len(tpl.Fields), embedFieldsMax)
+	}
+	for idx, field := range tpl.Fields {
+		name, err := exec(fmt.Sprintf("fields[%d].name", idx), field.Name, embedFieldNameMax)
+		if err != nil {
+			return nil, err
+		}
+		value, err := exec(fmt.Sprintf("fields[%d].value", idx), field.Value, embedFieldValueMax)
+		if err != nil {
+			return nil, err
+		}
+		if name == "" || value == "" {
+			continue // campos condicionales: {{if}} vacío oculta el campo
+		}
+		embed.Fields = append(embed.Fields, &discordgo.MessageEmbedField{Name: name, Value: value, Inline: field.Inline})
+	}
+	footer, err := exec("footer", tpl.Footer, embedFooterMax)
+	if err != nil {
+		return nil, err
+	}
+	if footer != "" {
+		embed.Footer = &discordgo.MessageEmbedFooter{Text: footer}
+	}
+	if embed.Title == "" && embed.Description == "" {
+		return nil, fmt.Errorf("title y description no pueden quedar vacíos a la vez")
+	}
+
+	color, err := exec("color", tpl.Color, 16)
+	if err != nil {
+		return nil, err
+	}
+	if embed.Color, err = parseEmbedColor(color, view.Player.Win); err != nil {
+		return nil, err
+	}
+
+	switch tpl.Image {
+	case "":
+	case "hero":
+		embed.Image = &discordgo.MessageEmbedImage{URL: view.Player.HeroImage}
+	default:
+		return nil, fmt.Errorf("image: %q no válido (hero o vacío)", tpl.Image)
+	}
+	switch tpl.Thumbnail {
+	case "":
+	case "hero":
+		embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: view.Player.HeroIcon}
+	case "avatar":
+		if view.Profile.Avatar != "" {
+			embed.Author = &discordgo.MessageEmbedAuthor{Name: view.Profile.Name, IconURL: view.Profile.Avatar}
+			embed.Thumbnail = &discordgo.MessageEmbedThumbnail{URL: view.Profile.Avatar}
+		}
+	default:
+		return nil, fmt.Errorf("thumbnail: %q no válido (avatar, hero o vacío)", tpl.Thumbnail)
+	}
+
+	if total := embedLength(embed); total > embedTotalMax {
+		return nil, fmt.Errorf("el embed tiene %d caracteres (máximo %d)", total, embedTotalMax)
+	}
+	return embed, nil
+}
+
+// parseEmbedColor convierte "#rrggbb" en color; vacío = verde si ganó, rojo si perdió.
+func parseEmbedColor(color string, win bool) (int, error) {
+	if color == "" {
+		if win {
+			return 0x2ecc71, nil
+		}
+		return 0xe74c3c, nil
+	}
+	value, err := strconv.ParseUint(strings.TrimPrefix(color, "#"), 16, 32)
+	if err != nil || len(strings.TrimPrefix(color, "#")) != 6 {
+		return 0, fmt.Errorf("color: %q no válido (usa #rrggbb)", color)
+	}
+	return int(value), nil
+}
+
+// embedLength suma los caracteres que Discord cuenta para el límite total del embed
+func embedLength(embed *discordgo.MessageEmbed) int {
+	total := len([]rune(embed.Title)) + len([]rune(embed.Description))
+	for _, field := range embed.Fields {
+		total += len([]rune(field.Name)) + len([]rune(field.Value))
+	}
+	if embed.Footer != nil {
+		total += len([]rune(embed.Footer.Text))
+	}
+	if embed.Author != nil {
+		total += len([]rune(embed.Author.Name))
+	}
+	return total
+}
+
+// buildMatchView arma la vista de la partida desde la perspectiva de player (mismos datos que buildMatchEmbed).
+func (b *Bot) buildMatchView(lang i18n.Lang, match *dota.MatchResponse, player *dota.Player, profile *dota.PlayersResponse, accountID string, highlights []dota.Highlight) MatchView {
+	isRadiant := player.IsRadiant == nil || *player.IsRadiant
+	win := match.RadiantWin != nil && *match.RadiantWin == isRadiant
+
+	view := MatchView{
+		Match: b.matchInfoView(lang, match),
+		Player: PlayerView{
+			Hero:        b.dotaClient.GetHeroName(player.HeroID),
+			HeroImage:   b.dotaClient.GetHeroImageURL(player.HeroID),
+			HeroIcon:    b.dotaClient.GetHeroIconURL(player.HeroID),
+			Win:         win,
+			Result:      i18n.T(lang, "match.loss"),
+			Team:        "Dire",
+			Kills:       player.Kills,
+			Deaths:      player.Deaths,
+			Assists:     player.Assists,
+			KDA:         player.KDA,
+			GPM:         player.GoldPerMin,
+			XPM:         player.XpPerMin,
+			Level:       player.Level,
+			HeroDamage:  player.HeroDamage,
+			TowerDamage: player.TowerDamage,
+			HeroHealing: player.HeroHealing,
+			NetWorth:    player.NetWorth,
+			Lane:        player.Lane,
+			Role:        player.Role,
+			HeroRecord:  b.heroRecordText(accountID, player.HeroID),
+		},
+		Profile: ProfileView{
+			Name: i18n.T(lang, "player.default"),
+			URL:  "https://stratz.com/players/" + accountID,
+		},
+	}
+	if view.Player.HeroImage == "" {
+		view.Player.HeroImage = dota.GetHeroImageURLStratz(player.HeroID)
+	}
+	if view.Player.HeroIcon == "" {
+		view.Player.HeroIcon = dota.GetHeroImageURLStratz(player.HeroID)
+	}
+	if win {
+		view.Player.Result = i18n.T(lang, "match.win")
+	}
+	if isRadiant {
+		view.Player.Team = "Radiant"
+	}
+	if itemsField := b.buildItemsField(lang, player); itemsField != nil {
+		view.Player.Items = itemsField.Value
+	}
+	if mvp := b.buildMVPFields(lang, match); len(mvp) == 2 {
+		view.Match.MVP, view.Match.Worst = mvp[0].Value, mvp[1].Value
+	}
+
+	if profile != nil {
+		if profile.Profile.Personaname != "" {
+			view.Profile.Name = profile.Profile.Personaname
+		}
+		view.Profile.Avatar = profile.Profile.Avatarfull
+		view.Profile.Bracket = profile.RankBracket
+		if profile.RankTier != nil {
+			view.Profile.Rank = dota.GetRankName(profile.RankTier)
+		}
+	} else if player.Personaname != "" {
+		view.Profile.Name = player.Personaname
+	}
+
+	if streak, ok := b.recentStreak(accountID); ok {
+		view.Streak = StreakView{Count: streak.StreakCount, Win: streak.IsWinStreak, Text: formatStreak(lang, streak)}
+	}
+
+	view.Lane.Phase, view.Lane.Summary = b.buildLaneOutcomeText(lang, match, player)
+	view.Lane.Top = formatLaneOutcomeWithColor(lang, match.TopLaneOutcome, isRadiant)
+	view.Lane.Mid = formatLaneOutcomeWithColor(lang, match.MidLaneOutcome, isRadiant)
+	view.Lane.Bottom = formatLaneOutcomeWithColor(lang, match.BottomLaneOutcome, isRadiant)
+
+	for _, h := range highlights {
+		view.Highlights = append(view.Highlights, h.Text(lang))
+	}
+	return view
+}
+
+// matchInfoView arma los datos de la partida que no dependen del jugador (MVP y peor se completan en buildMatchView).
+// Ranked usa el lobby_type ya traducido desde el enum de Stratz (RANKED = 7).
+func (b *Bot) matchInfoView(lang i18n.Lang, match *dota.MatchResponse) MatchInfoView {
+	return MatchInfoView{
+		ID:           match.MatchID,
+		URL:          fmt.Sprintf("https://stratz.com/matches/%d", match.MatchID),
+		Duration:     dota.FormatDuration(match.Duration),
+		GameMode:     dota.GameModeDisplayName(b.dotaClient.GetGameModeName(match.GameMode)),
+		Ranked:       match.LobbyType == dota.LobbyTypeRanked,
+		RadiantWin:   match.RadiantWin != nil && *match.RadiantWin,
+		RadiantScore: match.RadiantScore,
+		DireScore:    match.DireScore,
+		Scoring:      dota.ScoringDescription(lang),
+	}
+}
+
+// sampleMatchView es una partida de ejemplo para previsualizar plantillas sin llamar a Stratz.
+func (b *Bot) sampleMatchView(lang i18n.Lang) MatchView {
+	const heroID = 1 // Anti-Mage
+	return MatchView{
+		Match: MatchInfoView{
+			ID:           8000000000,
+			URL:          "https://stratz.com/matches/8000000000",
+			Duration:     "41:27",
+			GameMode:     "ALL PICK",
+			Ranked:       true,
+			RadiantWin:   true,
+			RadiantScore: 42,
+			DireScore:    27,
+			MVP:          fmt.Sprintf("**%s** — %s\n14/2/9 • 81 pts", b.dotaClient.GetHeroName(heroID), i18n.T(lang, "template.sample_player")),
+			Worst:        fmt.Sprintf("**%s** — %s\n1/11/4 • 12 pts", b.dotaClient.GetHeroName(2), i18n.T(lang, "scoreboard.anonymous")),
+			Scoring:      dota.ScoringDescription(lang),
+		},
+		Player: PlayerView{
+			Hero:        b.dotaClient.GetHeroName(heroID),
+			HeroImage:   b.dotaClient.GetHeroImageURL(heroID),
+			HeroIcon:    b.dotaClient.GetHeroIconURL(heroID),
+			Win:         true,
+			Result:      i18n.T(lang, "match.win"),
+			Team:        "Radiant",
+			Kills:       14,
+			Deaths:      2,
+			Assists:     9,
+			KDA:         11.5,
+			GPM:         812,
+			XPM:         901,
+			Level:       26,
+			HeroDamage:  31245,
+			TowerDamage: 9120,
+			NetWorth:    34810,
+			Lane:        "SAFE_LANE",
+			Role:        "CORE",
+			HeroRecord:  "12-6 (66.7%)",
+			Items:       "Power Treads · Battle Fury · Manta Style · Butterfly · Abyssal Blade · Skull Basher",
+		},
+		Profile: ProfileView{
+			Name:    i18n.T(lang, "template.sample_player"),
+			Bracket: "LEGEND",
+			Rank:    "Legend 4",
+			URL:     "https://stratz.com/players/0",
+		},
+		Streak: StreakView{Count: 3, Win: true, Text: i18n.T(lang, "match.streak_win", 3)},
+		Lane: LaneView{
+			Phase:   i18n.T(lang, "lane.phase_win"),
+			Summary: fmt.Sprintf("Top: %s\nMid: %s\n%s: %s", i18n.T(lang, "lane.tie"), "🔴 "+i18n.T(lang, "lane.dire_victory"), i18n.T(lang, "lane.you", "Bottom"), "🟢 "+i18n.T(lang, "lane.radiant_stomp")),
+			Top:     i18n.T(lang, "lane.tie"),
+			Mid:     "🔴 " + i18n.T(lang, "lane.dire_victory"),
+			Bottom:  "🟢 " + i18n.T(lang, "lane.radiant_stomp"),
+		},
+	}
+}
@@ -0,0 +1,35 @@
+package discord
+
+import (
+	"dota-discord-bot/dota"
+	"dota-discord-bot/i18n"
+	"dota-discord-bot/storage"
+	"strings"
+	"testing"
+)
+
+func TestTemplateRankedBranch(t *testing.T) {
+	b := &Bot{dotaClient: dota.NewClient()}
+	tpl := storage.NotificationTemplate{
+		Title:       `{{if .Match.Ranked}}Ranked{{else}}Normal{{end}} · {{.Match.GameMode}}`,
+		Description: "{{.Match.RadiantScore}}-{{.Match.DireScore}}",
+	}
+	tests := []struct {
+		lobbyType int
+		want      string
+	}{
+		{dota.LobbyTypeRanked, "Ranked · "},
+		{dota.LobbyTypeNormal, "Normal · "},
+	}
+	for _, tt := range tests {
+		match := &dota.MatchResponse{MatchID: 1, GameMode: dota.GameModeAllPick, LobbyType: tt.lobbyType}
+		view := MatchView{Match: b.matchInfoView(i18n.Default, match)}
+		embed, err := renderTemplate(i18n.Default, tpl, view)
+		if err != nil {
+			t.Fatalf("lobby %d: %v", tt.lobbyType, err)
+		}
+		if !strings.HasPrefix(embed.Title, tt.want) {
+			t.Errorf("lobby %d: título %q, se esperaba que empezara por %q", tt.lobbyType, embed.Title, tt.want)
+		}
+	}
+}
@@ -5,8 +5,6 @@ import (
 	"fmt"
 	"io"
 	"net/http"
-	"os"
-	"path/filepath"
 	"strconv"
 	"strings"
 	"time"
@@ -20,13 +18,8 @@ const (
 )
 
 type Client struct {
-	httpClient      *http.Client
-	heroesCache     map[int]string
-	heroImages      map[int]string
-	heroSlugCache   map[int]string
-	gameModes       map[int]string
-	lobbyTypes      map[int]string
-	lastCacheUpdate time.Time
+	httpClient *http.Client
+	constants  *Constants
 }
 
 func NewClient() *Client {
@@ -34,14 +27,21 @@ func NewClient() *Client {
 		httpClient: &http.Client{
 			Timeout: 10 * time.Second,
 		},
-		heroesCache:   make(map[int]string),
-		heroImages:    make(map[int]string),
-		heroSlugCache: make(map[int]string),
-		gameModes:     make(map[int]string),
-		lobbyTypes:    make(map[int]string),
+		constants: DefaultConstants(),
 	}
 }
 
+// SetConstants sustituye las constantes embebidas (ej. cargadas con LoadConstants desde CONSTANTS_DIR).
+// Llamar antes de usar el cliente desde otros goroutines.
+func (c *Client) SetConstants(constants *Constants) {
+	c.constants = constants
+}
+
+// Constants devuelve el registro de constantes del cliente
+func (c *Client) Constants() *Constants {
+	return c.constants
+}
+
 // SearchResponse representa un resultado de búsqueda
 type SearchResponse struct {
 	AccountID     int     `json:"account_id"`
@@ -83,30 +83,38 @@ type MatchResponse struct {
 	TopLaneOutcome    string   `json:"top_lane_outcome"`    // TIE, RADIANT_VICTORY, RADIANT_STOMP, DIRE_VICTORY, DIRE_STOMP
 	MidLaneOutcome    string   `json:"mid_lane_outcome"`    // idem
 	BottomLaneOutcome string   `json:"bottom_lane_outcome"` // idem
+	RadiantGoldAdv    []int    `json:"radiant_gold_adv"`    // ventaja de oro de Radiant por minuto (negativo = Dire)
+	RadiantXPAdv      []int    `json:"radiant_xp_adv"`      // ventaja de XP de Radiant por minuto
+	Parsed            bool     `json:"-"`                   // Stratz ya parseó la partida (IsMatchParsed)
 }
 
 // Player representa un jugador en una partida
 type Player struct {
-	AccountID   int     `json:"account_id"`
-	PlayerSlot  int     `json:"player_slot"`
-	HeroID      int     `json:"hero_id"`
-	Kills       int     `json:"kills"`
-	Deaths      int     `json:"deaths"`
-	Assists     int     `json:"assists"`
-	Win         *int    `json:"win"`
-	Lose        *int    `json:"lose"`
-	IsRadiant   *bool   `json:"isRadiant"`
-	Personaname string  `json:"personaname"`
-	Level       int     `json:"level"`
-	GoldPerMin  int     `json:"gold_per_min"`
-	XpPerMin    int     `json:"xp_per_min"`
-	HeroDamage  int     `json:"hero_damage"`
-	TowerDamage int     `json:"tower_damage"`
-	HeroHealing int     `json:"hero_healing"`
-	KDA         float64 `json:"kda"`
-	RankTier    *int    `json:"rank_tier"`
-	Lane        string  `json:"lane"` // lane/rol del jugador (Stratz: SAFE_LANE, MID_LANE, etc.)
-	Role        string  `json:"role"` // CORE, SUPPORT, etc.
+	AccountID     int            `json:"account_id"`
+	PlayerSlot    int            `json:"player_slot"`
+	HeroID        int            `json:"hero_id"`
+	Kills         int            `json:"kills"`
+	Deaths        int            `json:"deaths"`
+	Assists       int            `json:"assists"`
+	Win           *int           `json:"win"`
+	Lose          *int           `json:"lose"`
+	IsRadiant     *bool          `json:"isRadiant"`
+	Personaname   string         `json:"personaname"`
+	Level         int            `json:"level"`
+	GoldPerMin    int            `json:"gold_per_min"`
+	XpPerMin      int            `json:"xp_per_min"`
+	HeroDamage    int            `json:"hero_damage"`
+	TowerDamage   int            `json:"tower_damage"`
+	HeroHealing   int            `json:"hero_healing"`
+	NetWorth      int            `json:"net_worth"`
+	KDA           float64        `json:"kda"`
+	RankTier      *int           `json:"rank_tier"`
+	Lane          string         `json:"lane"`           // lane/rol del jugador (Stratz: SAFE_LANE, MID_LANE, etc.)
+	Role          string         `json:"role"`           // CORE, SUPPORT, etc.
+	Items         []int          `json:"items"`          // inventario final (slots 0-5; 0 = vacío)
+	Backpack      []int          `json:"backpack"`       // mochila (slots 0-2; 0 = vacío)
+	NeutralItem   int            `json:"neutral_item"`   // 0 = sin neutral
+	ItemPurchases []ItemPurchase `json:"item_purchases"` // solo partidas parseadas
 }
 
 // PlayersResponse representa el perfil de un jugador
@@ -125,12 +133,15 @@ type PlayersResponse struct {
 	RankBracket     string   `json:"rank_bracket"` // Stratz: UNCALIBRATED, HERALD, GUARDIAN, CRUSADER, ARCHON, LEGEND, ANCIENT, DIVINE, IMMORTAL
 }
 
-// Hero representa un héroe
+// Hero representa un héroe (entrada de heroes.json); ver HeroInfo para la versión enriquecida
 type Hero struct {
-	ID            int    `json:"id"`
-	Name          string `json:"name"`
-	LocalizedName string `json:"localized_name"`
-	Img           string `json:"img"`
+	ID            int      `json:"id"`
+	Name          string   `json:"name"`
+	LocalizedName string   `json:"localized_name"`
+	Img           string   `json:"img"`
+	PrimaryAttr   string   `json:"primary_attr"` // str, agi, int, all
+	AttackType    string   `json:"attack_type"`  // Melee, Ranged
+	Roles         []string `json:"roles"`        // Carry, Support, Nuker…
 }
 
 // WinLossResponse representa el resumen W/L de un jugador
@@ -277,16 +288,15 @@ func (c *Client) IsWinFromPlayer(player Player, radiantWin *bool) bool {
 }
 
 type StreakResult struct {
-	Wins          int
-	Losses        int
-	CurrentStreak string
-	StreakCount   int
-	IsWinStreak   bool
+	Wins        int
+	Losses      int
+	StreakCount int
+	IsWinStreak bool
 }
 
 func (c *Client) AnalyzeStreak(matches []PlayerRecentMatch) StreakResult {
 	if len(matches) == 0 {
-		return StreakResult{CurrentStreak: "Sin partidas"}
+		return StreakResult{}
 	}
 
 	wins := 0
@@ -313,67 +323,23 @@ func (c *Client) AnalyzeStreak(matches []PlayerRecentMatch) StreakResult {
 
 	isWinStreak := isFirstWin
 
-	var currentStreak string
-	if isWinStreak {
-		currentStreak = fmt.Sprintf("%d victorias consecutivas 🔥", streakCount)
-	} else {
-		currentStreak = fmt.Sprintf("%d derrotas consecutivas 💀", streakCount)
-	}
-
 	return StreakResult{
-		Wins:          wins,
-		Losses:        losses,
-		CurrentStreak: currentStreak,
-		StreakCount:   streakCount,
-		IsWinStreak:   isWinStreak,
-	}
-}
-
-func (c *Client) LoadConstants() error {
-	// Cargar héroes
-	var heroes []Hero
-	url := fmt.Sprintf("%s/constants/heroes", baseURL)
-	if err := c.makeRequest(url, &heroes); err != nil {
-		return fmt.Errorf("error cargando héroes: %w", err)
-	}
-	for _, hero := range heroes {
-		c.heroesCache[hero.ID] = hero.LocalizedName
-		c.heroImages[hero.ID] = hero.Img
-		if hero.Name != "" {
-			slug := strings.TrimPrefix(hero.Name, "npc_dota_hero_")
-			if slug != "" {
-				c.heroSlugCache[hero.ID] = slug
-			}
-		}
-	}
-
-	// Cargar game modes
-	url = fmt.Sprintf("%s/constants/game_mode", baseURL)
-	if err := c.makeRequest(url, &c.gameModes); err != nil {
-		// No crítico, continuar sin game modes
-	}
-
-	// Cargar lobby types
-	url = fmt.Sprintf("%s/constants/lobby_type", baseURL)
-	if err := c.makeRequest(url, &c.lobbyTypes); err != nil {
-		// No crítico, continuar sin lobby types
+		Wins:        wins,
+		Losses:      losses,
+		StreakCount: streakCount,
+		IsWinStreak: isWinStreak,
 	}
-
-	c.lastCacheUpdate = time.Now()
-	return nil
 }
 
 func (c *Client) GetHeroName(heroID int) string {
-	c.loadHeroesLocal()
-	if name, ok := c.heroesCache[heroID]; ok {
-		return name
+	if h, ok := c.constants.Hero(heroID); ok && h.LocalizedName != "" {
+		return h.LocalizedName
 	}
 	return fmt.Sprintf("Hero %d", heroID)
 }
 
 func (c *Client) GetGameModeName(gameMode int) string {
-	c.loadGameModesLocal()
-	if name, ok := c.gameModes[gameMode]; ok {
+	if name, ok := c.constants.GameModeName(gameMode); ok {
 		return name
 	}
 	return fmt.Sprintf("Mode %d", gameMode)
@@ -387,13 +353,19 @@ func GameModeDisplayName(internalName string) string {
 }
 
 func (c *Client) GetLobbyTypeName(lobbyType int) string {
-	c.loadLobbyTypesLocal()
-	if name, ok := c.lobbyTypes[lobbyType]; ok {
+	if name, ok := c.constants.LobbyTypeName(lobbyType); ok {
 		return name
 	}
 	return fmt.Sprintf("Lobby %d", lobbyType)
 }
 
+// LobbyTypeDisplayName convierte el nombre interno (ej. lobby_type_ranked) a display limpio en mayúsculas (ej. RANKED)
+func LobbyTypeDisplayName(internalName string) string {
+	s := strings.TrimPrefix(internalName, "lobby_type_")
+	s = strings.ReplaceAll(s, "_", " ")
+	return strings.ToUpper(s)
+}
+
 func FormatDuration(seconds int) string {
 	hours := seconds / 3600
 	minutes := (seconds % 3600) / 60
@@ -434,93 +406,38 @@ func GetRankName(rankTier *int) string {
 	return fmt.Sprintf("Rank %d", rank)
 }
 
-// --- Helpers para cargar caches locales ---
-
-func (c *Client) loadHeroesLocal() {
-	if len(c.heroesCache) > 0 && len(c.heroSlugCache) > 0 {
-		return
-	}
-	data, err := os.ReadFile(filepath.Join("dota", "heroes.json"))
-	if err != nil {
-		return
-	}
-	var raw map[string]Hero
-	if err := json.Unmarshal(data, &raw); err != nil {
-		return
-	}
-	for _, h := range raw {
-		if h.LocalizedName != "" {
-			c.heroesCache[h.ID] = h.LocalizedName
-		}
-		if h.Img != "" {
-			c.heroImages[h.ID] = h.Img
-		}
-		if h.Name != "" {
-			slug := strings.TrimPrefix(h.Name, "npc_dota_hero_")
-			if slug != "" {
-				c.heroSlugCache[h.ID] = slug
-			}
-		}
-	}
+// rankBracketOrder ordena los rankBracket de Stratz de menor a mayor
+var rankBracketOrder = map[string]int{
+	"UNCALIBRATED": 0,
+	"HERALD":       1,
+	"GUARDIAN":     2,
+	"CRUSADER":     3,
+	"ARCHON":       4,
+	"LEGEND":       5,
+	"ANCIENT":      6,
+	"DIVINE":       7,
+	"IMMORTAL":     8,
 }
 
-func (c *Client) loadGameModesLocal() {
-	if len(c.gameModes) > 0 {
-		return
-	}
-	data, err := os.ReadFile(filepath.Join("dota", "game_mode.json"))
-	if err != nil {
-		return
-	}
-	var raw map[string]struct {
-		ID   int    `json:"id"`
-		Name string `json:"name"`
-	}
-	if err := json.Unmarshal(data, &raw); err != nil {
-		return
-	}
-	for _, gm := range raw {
-		if gm.Name != "" {
-			c.gameModes[gm.ID] = gm.Name
-		}
-	}
+// RankBracketOrder devuelve la posición del rankBracket (0 = sin calibrar/desconocido, 8 = Immortal)
+func RankBracketOrder(bracket string) int {
+	return rankBracketOrder[strings.ToUpper(bracket)]
 }
 
-func (c *Client) loadLobbyTypesLocal() {
-	if len(c.lobbyTypes) > 0 {
-		return
-	}
-	data, err := os.ReadFile(filepath.Join("dota", "lobby_type.json"))
-	if err != nil {
-		return
-	}
-	var raw map[string]struct {
-		ID   int    `json:"id"`
-		Name string `json:"name"`
-	}
-	if err := json.Unmarshal(data, &raw); err != nil {
-		return
-	}
-	for _, lt := range raw {
-		if lt.Name != "" {
-			c.lobbyTypes[lt.ID] = lt.Name
-		}
-	}
+// FindHeroID busca un héroe por ID, slug, nombre, alias o parecido (ver Constants.FindHero).
+func (c *Client) FindHeroID(name string) (int, bool) {
+	h, ok := c.constants.FindHero(name)
+	return h.ID, ok
 }
 
 // GetHeroSlug retorna el slug del héroe para URLs (ej. antimage, abaddon)
 func (c *Client) GetHeroSlug(heroID int) string {
-	c.loadHeroesLocal()
-	if slug, ok := c.heroSlugCache[heroID]; ok {
-		return slug
-	}
-	return ""
+	return c.constants.HeroSlug(heroID)
 }
 
 // GetHeroImageURL retorna la URL de la imagen del héroe desde Steam CDN (dota_react/heroes/{slug}.png)
 func (c *Client) GetHeroImageURL(heroID int) string {
-	c.loadHeroesLocal()
-	if slug, ok := c.heroSlugCache[heroID]; ok && slug != "" {
+	if slug := c.constants.HeroSlug(heroID); slug != "" {
 		return fmt.Sprintf("%s/%s.png", steamCDNHeroes, slug)
 	}
 	return ""
@@ -528,8 +445,7 @@ func (c *Client) GetHeroImageURL(heroID int) string {
 
 // GetHeroIconURL retorna la URL del icono/miniatura del héroe desde Steam CDN (mismo CDN que imagen)
 func (c *Client) GetHeroIconURL(heroID int) string {
-	c.loadHeroesLocal()
-	if slug, ok := c.heroSlugCache[heroID]; ok && slug != "" {
+	if slug := c.constants.HeroSlug(heroID); slug != "" {
 		return fmt.Sprintf("%s/%s.png", steamCDNHeroes, slug)
 	}
 	return ""
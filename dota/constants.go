@@ -0,0 +1,191 @@
+package dota
+
+import (
+	"embed"
+	"encoding/json"
+	"fmt"
+	"os"
+	"path/filepath"
+	"sort"
+	"strings"
+	"sync"
+)
+
+// Constantes de OpenDota (heroes, game_mode, lobby_type, items) compiladas en el binario.
+// Se actualizan con: go run ./cmd/update_constants. hero_meta.json (complejidad y alias) se mantiene a mano.
+//
+//go:embed heroes.json game_mode.json lobby_type.json items.json hero_meta.json
+var embeddedConstants embed.FS
+
+// ConstantNames son los recursos de /api/constants de OpenDota que usa el bot; cada uno se guarda como {nombre}.json
+var ConstantNames = []string{"heroes", "game_mode", "lobby_type", "items"}
+
+// Constants es el registro de constantes de Dota 2. Se construye una vez y después solo se lee,
+// así que es seguro usarlo desde varios goroutines sin locks.
+type Constants struct {
+	heroes     map[int]Hero
+	heroSlugs  map[int]string // ej. antimage, abaddon
+	heroInfos  map[int]HeroInfo
+	gameModes  map[int]string
+	lobbyTypes map[int]string
+	items      map[int]Item
+}
+
+// embeddedSource es el origen que readConstant devuelve para los archivos compilados en el binario
+const embeddedSource = "embebido"
+
+var (
+	defaultConstantsOnce sync.Once
+	defaultConstants     *Constants
+)
+
+// DefaultConstants devuelve las constantes embebidas en el binario (se parsean una sola vez).
+func DefaultConstants() *Constants {
+	defaultConstantsOnce.Do(func() {
+		c, err := LoadConstants("")
+		if err != nil {
+			// Solo puede fallar si el JSON embebido está corrupto: es un error de compilación, no de ejecución
+			panic(fmt.Sprintf("constantes embebidas inválidas: %v", err))
+		}
+		defaultConstants = c
+	})
+	return defaultConstants
+}
+
+// LoadConstants carga las constantes usando, para cada archivo, {overrideDir}/{nombre}.json si existe
+// y la versión embebida si no (overrideDir vacío = solo embebidas).
+func LoadConstants(overrideDir string) (*Constants, error) {
+	c := &Constants{
+		heroes:     make(map[int]Hero),
+		heroSlugs:  make(map[int]string),
+		heroInfos:  make(map[int]HeroInfo),
+		gameModes:  make(map[int]string),
+		lobbyTypes: make(map[int]string),
+		items:      make(map[int]Item),
+	}
+
+	var heroes map[string]Hero
+	if _, err := readConstant(overrideDir, "heroes", &heroes); err != nil {
+		return nil, err
+	}
+	for _, h := range heroes {
+		c.heroes[h.ID] = h
+		if slug := strings.TrimPrefix(h.Name, "npc_dota_hero_"); slug != "" {
+			c.heroSlugs[h.ID] = slug
+		}
+	}
+
+	var meta map[string]heroMeta
+	if _, err := readConstant(overrideDir, "hero_meta", &meta); err != nil {
+		return nil, err
+	}
+	for id, h := range c.heroes {
+		c.heroInfos[id] = newHeroInfo(h, c.heroSlugs[id], meta[c.heroSlugs[id]])
+	}
+
+	var named map[string]struct {
+		ID   int    `json:"id"`
+		Name string `json:"name"`
+	}
+	if _, err := readConstant(overrideDir, "game_mode", &named); err != nil {
+		return nil, err
+	}
+	for _, gm := range named {
+		if gm.Name != "" {
+			c.gameModes[gm.ID] = gm.Name
+		}
+	}
+	named = nil
+	if _, err := readConstant(overrideDir, "lobby_type", &named); err != nil {
+		return nil, err
+	}
+	for _, lt := range named {
+		if lt.Name != "" {
+			c.lobbyTypes[lt.ID] = lt.Name
+		}
+	}
+
+	var items map[string]Item
+	source, err := readConstant(overrideDir, "items", &items)
+	if err != nil {
+		return nil, err
+	}
+	// El items.json embebido todavía es un subconjunto sin neutrales hasta que se regenere con make update-constants
+	// (necesita acceso a OpenDota); mientras tanto la comprobación solo se aplica a CONSTANTS_DIR
+	if source != embeddedSource {
+		if _, err := checkNeutralItems(items); err != nil {
+			return nil, fmt.Errorf("items.json (%s): %w", source, err)
+		}
+	}
+	for name, item := range items {
+		item.Name = name
+		c.items[item.ID] = item
+	}
+	return c, nil
+}
+
+// readConstant lee {name}.json de overrideDir (si existe) o del binario y lo decodifica en out;
+// devuelve de dónde lo leyó (la ruta o embeddedSource).
+func readConstant(overrideDir, name string, out interface{}) (string, error) {
+	file := name + ".json"
+	var data []byte
+	var err error
+	source := embeddedSource
+	if overrideDir != "" {
+		path := filepath.Join(overrideDir, file)
+		data, err = os.ReadFile(path)
+		if err == nil {
+			source = path
+		} else if !os.IsNotExist(err) {
+			return "", fmt.Errorf("error leyendo %s: %w", path, err)
+		}
+	}
+	if data == nil {
+		if data, err = embeddedConstants.ReadFile(file); err != nil {
+			return "", fmt.Errorf("error leyendo %s embebido: %w", file, err)
+		}
+	}
+	if err := json.Unmarshal(data, out); err != nil {
+		return "", fmt.Errorf("error parseando %s (%s): %w", file, source, err)
+	}
+	return source, nil
+}
+
+// Hero devuelve el héroe por ID
+func (c *Constants) Hero(heroID int) (Hero, bool) {
+	h, ok := c.heroes[heroID]
+	return h, ok
+}
+
+// HeroIDs devuelve los IDs de todos los héroes ordenados
+func (c *Constants) HeroIDs() []int {
+	ids := make([]int, 0, len(c.heroes))
+	for id := range c.heroes {
+		ids = append(ids, id)
+	}
+	sort.Ints(ids)
+	return ids
+}
+
+// HeroSlug devuelve el slug del héroe (ej. antimage) o "" si no existe
+func (c *Constants) HeroSlug(heroID int) string {
+	return c.heroSlugs[heroID]
+}
+
+// GameModeName devuelve el nombre interno del modo de juego (ej. game_mode_all_pick)
+func (c *Constants) GameModeName(gameMode int) (string, bool) {
+	name, ok := c.gameModes[gameMode]
+	return name, ok
+}
+
+// LobbyTypeName devuelve el nombre interno del tipo de lobby (ej. lobby_type_ranked)
+func (c *Constants) LobbyTypeName(lobbyType int) (string, bool) {
+	name, ok := c.lobbyTypes[lobbyType]
+	return name, ok
+}
+
+// Item devuelve el item por ID
+func (c *Constants) Item(itemID int) (Item, bool) {
+	item, ok := c.items[itemID]
+	return item, ok
+}
@@ -0,0 +1,27 @@
+package dota
+
+import (
+	"os"
+	"path/filepath"
+	"testing"
+)
+
+func TestLoadConstantsRequiresNeutralItems(t *testing.T) {
+	tests := []struct {
+		items   string
+		wantErr bool
+	}{
+		{`{"blink": {"id": 1, "dname": "Blink Dagger", "cost": 2250}}`, true},
+		{`{"blink": {"id": 1, "dname": "Blink Dagger", "cost": 2250}, "keen_optic": {"id": 287, "dname": "Keen Optic", "tier": 1}}`, false},
+	}
+	for _, tt := range tests {
+		dir := t.TempDir()
+		if err := os.WriteFile(filepath.Join(dir, "items.json"), []byte(tt.items), 0644); err != nil {
+			t.Fatal(err)
+		}
+		_, err := LoadConstants(dir)
+		if (err != nil) != tt.wantErr {
+			t.Errorf("%s: error = %v, se esperaba error=%v", tt.items, err, tt.wantErr)
+		}
+	}
+}
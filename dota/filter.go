@@ -0,0 +1,73 @@
+package dota
+
+import (
+	"fmt"
+	"strings"
+	"time"
+)
+
+// IDs de Stratz para filtros de matches(request: …)
+const (
+	StratzRoleCore         = 0 // MatchPlayerRoleType.CORE
+	StratzRoleLightSupport = 1 // MatchPlayerRoleType.LIGHT_SUPPORT
+	StratzRoleHardSupport  = 2 // MatchPlayerRoleType.HARD_SUPPORT
+
+	StratzLaneSafe = 1 // MatchLaneType.SAFE_LANE
+	StratzLaneMid  = 2 // MatchLaneType.MID_LANE
+	StratzLaneOff  = 3 // MatchLaneType.OFF_LANE
+
+	LobbyTypeNormal  = 0  // lobby_type_normal (matchmaking sin ranked)
+	LobbyTypeRanked  = 7  // lobby_type_ranked (lobby_type.json)
+	GameModeAllPick  = 1  // game_mode_all_pick (game_mode.json)
+	GameModeAllDraft = 22 // game_mode_all_draft (all pick de ranked)
+	GameModeTurbo    = 23 // game_mode_turbo
+)
+
+// MatchFilter son los filtros opcionales de Stratz para las partidas de un jugador (vacío = sin filtro)
+type MatchFilter struct {
+	HeroIDs       []int
+	RoleIDs       []int // StratzRole*
+	LaneIDs       []int // StratzLane*
+	GameModeIDs   []int
+	LobbyTypeIDs  []int
+	StartDateTime int64 // unix; 0 = sin límite
+	EndDateTime   int64 // unix; 0 = sin límite
+}
+
+// PatchFilter devuelve un filtro de las partidas del "parche actual" (últimos statsPatchDays días)
+func PatchFilter() MatchFilter {
+	return MatchFilter{StartDateTime: time.Now().AddDate(0, 0, -statsPatchDays).Unix()}
+}
+
+// IsEmpty indica si el filtro no restringe nada
+func (f MatchFilter) IsEmpty() bool {
+	return len(f.HeroIDs) == 0 && len(f.RoleIDs) == 0 && len(f.LaneIDs) == 0 &&
+		len(f.GameModeIDs) == 0 && len(f.LobbyTypeIDs) == 0 && f.StartDateTime == 0 && f.EndDateTime == 0
+}
+
+// requestFields devuelve los campos extra de PlayerMatchesRequestType para el literal GraphQL (ej. ", roleIds: [0]")
+func (f MatchFilter) requestFields() string {
+	var sb strings.Builder
+	writeIDs := func(name string, ids []int) {
+		if len(ids) == 0 {
+			return
+		}
+		parts := make([]string, len(ids))
+		for i, id := range ids {
+			parts[i] = fmt.Sprintf("%d", id)
+		}
+		fmt.Fprintf(&sb, ", %s: [%s]", name, strings.Join(parts, ", "))
+	}
+	writeIDs("heroIds", f.HeroIDs)
+	writeIDs("roleIds", f.RoleIDs)
+	writeIDs("laneIds", f.LaneIDs)
+	writeIDs("gameModeIds", f.GameModeIDs)
+	writeIDs("lobbyTypeIds", f.LobbyTypeIDs)
+	if f.StartDateTime > 0 {
+		fmt.Fprintf(&sb, ", startDateTime: %d", f.StartDateTime)
+	}
+	if f.EndDateTime > 0 {
+		fmt.Fprintf(&sb, ", endDateTime: %d", f.EndDateTime)
+	}
+	return sb.String()
+}
@@ -0,0 +1,166 @@
+package dota
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+)
+
+// heroFuzzyMaxDistance es la distancia de edición máxima para aceptar un nombre mal escrito (ej. "invokr")
+const heroFuzzyMaxDistance = 2
+
+// HeroInfo es la información de un héroe: datos de heroes.json más complejidad y alias de hero_meta.json
+type HeroInfo struct {
+	ID          int
+	Slug        string   // ej. antimage
+	Name        string   // nombre localizado, ej. Anti-Mage
+	PrimaryAttr string   // str, agi, int, all
+	AttackType  string   // Melee, Ranged
+	Roles       []string // Carry, Support, Nuker…
+	Complexity  int      // 1-3 (0 = desconocida)
+	Aliases     []string // apodos, ej. am, magina
+}
+
+// heroMeta es una entrada de hero_meta.json (por slug)
+type heroMeta struct {
+	Complexity int      `json:"complexity"`
+	Aliases    []string `json:"aliases"`
+}
+
+func newHeroInfo(h Hero, slug string, meta heroMeta) HeroInfo {
+	return HeroInfo{
+		ID:          h.ID,
+		Slug:        slug,
+		Name:        h.LocalizedName,
+		PrimaryAttr: h.PrimaryAttr,
+		AttackType:  h.AttackType,
+		Roles:       h.Roles,
+		Complexity:  meta.Complexity,
+		Aliases:     meta.Aliases,
+	}
+}
+
+// HeroInfo devuelve la información del héroe por ID
+func (c *Constants) HeroInfo(heroID int) (HeroInfo, bool) {
+	h, ok := c.heroInfos[heroID]
+	return h, ok
+}
+
+// HeroInfos devuelve todos los héroes ordenados por nombre
+func (c *Constants) HeroInfos() []HeroInfo {
+	out := make([]HeroInfo, 0, len(c.heroInfos))
+	for _, h := range c.heroInfos {
+		out = append(out, h)
+	}
+	sort.Slice(out, func(a, b int) bool { return out[a].Name < out[b].Name })
+	return out
+}
+
+// FindHero busca un héroe por ID ("1"), slug ("antimage"), nombre ("Anti-Mage", "anti mage"), alias ("am")
+// o parecido (prefijo, subcadena o hasta 2 letras de diferencia). Devuelve el mejor resultado.
+func (c *Constants) FindHero(query string) (HeroInfo, bool) {
+	if id, err := strconv.Atoi(strings.TrimSpace(query)); err == nil {
+		return c.HeroInfo(id)
+	}
+	if normalizeHeroQuery(query) == "" {
+		return HeroInfo{}, false
+	}
+	matches := c.SearchHeroes(query, 1)
+	if len(matches) == 0 {
+		return HeroInfo{}, false
+	}
+	return matches[0], true
+}
+
+// SearchHeroes devuelve hasta limit héroes que coinciden con query, del mejor al peor
+// (coincidencia exacta, prefijo, subcadena, parecido); limit <= 0 = sin límite. Query vacía = todos por nombre.
+func (c *Constants) SearchHeroes(query string, limit int) []HeroInfo {
+	q := normalizeHeroQuery(query)
+	type scored struct {
+		hero  HeroInfo
+		score int
+	}
+	var results []scored
+	for _, h := range c.HeroInfos() {
+		if score, ok := heroMatchScore(h, q); ok {
+			results = append(results, scored{hero: h, score: score})
+		}
+	}
+	sort.SliceStable(results, func(a, b int) bool { return results[a].score < results[b].score })
+	if limit > 0 && len(results) > limit {
+		results = results[:limit]
+	}
+	out := make([]HeroInfo, len(results))
+	for idx, r := range results {
+		out[idx] = r.hero
+	}
+	return out
+}
+
+// heroMatchScore puntúa qué tan bien coincide q (normalizada) con el héroe: menor = mejor.
+func heroMatchScore(h HeroInfo, q string) (int, bool) {
+	if q == "" {
+		return 0, true
+	}
+	keys := []string{normalizeHeroQuery(h.Name), normalizeHeroQuery(h.Slug)}
+	for _, alias := range h.Aliases {
+		keys = append(keys, normalizeHeroQuery(alias))
+	}
+	best := -1
+	consider := func(score int) {
+		if best < 0 || score < best {
+			best = score
+		}
+	}
+	for _, key := range keys {
+		switch {
+		case key == "":
+			continue
+		case key == q:
+			consider(0)
+		case strings.HasPrefix(key, q):
+			consider(10)
+		case strings.Contains(key, q):
+			consider(20)
+		case len(q) >= 4:
+			if d := levenshtein(key, q); d <= heroFuzzyMaxDistance {
+				consider(30 + d)
+			}
+		}
+	}
+	return best, best >= 0
+}
+
+// normalizeHeroQuery pasa a minúsculas y quita todo lo que no sea letra o número ("Anti-Mage" → "antimage")
+func normalizeHeroQuery(s string) string {
+	var b strings.Builder
+	for _, r := range strings.ToLower(s) {
+		if unicode.IsLetter(r) || unicode.IsDigit(r) {
+			b.WriteRune(r)
+		}
+	}
+	return b.String()
+}
+
+// levenshtein devuelve la distancia de edición entre a y b
+func levenshtein(a, b string) int {
+	ra, rb := []rune(a), []rune(b)
+	prev := make([]int, len(rb)+1)
+	cur := make([]int, len(rb)+1)
+	for j := range prev {
+		prev[j] = j
+	}
+	for i := 1; i <= len(ra); i++ {
+		cur[0] = i
+		for j := 1; j <= len(rb); j++ {
+			cost := 1
+			if ra[i-1] == rb[j-1] {
+				cost = 0
+			}
+			cur[j] = min(prev[j]+1, cur[j-1]+1, prev[j-1]+cost)
+		}
+		prev, cur = cur, prev
+	}
+	return prev[len(rb)]
+}
@@ -0,0 +1,233 @@
+package dota
+
+import (
+	"dota-discord-bot/i18n"
+	"strings"
+)
+
+// HighlightTag identifica una regla de partida destacada
+type HighlightTag string
+
+const (
+	HighlightKills        HighlightTag = "kills"          // 20+ kills
+	HighlightDeathless    HighlightTag = "deathless"      // 0 muertes
+	HighlightGPM          HighlightTag = "gpm"            // más de 1000 GPM
+	HighlightComeback     HighlightTag = "comeback"       // línea perdida por stomp y partida ganada
+	HighlightLongestGame  HighlightTag = "longest_game"   // partida más larga del mes
+	HighlightWinStreak    HighlightTag = "win_streak"     // 10+ victorias seguidas
+	HighlightLossStreak   HighlightTag = "loss_streak"    // 10+ derrotas seguidas
+	HighlightHeroDamagePB HighlightTag = "hero_damage_pb" // récord personal de daño a héroes
+	HighlightHealingPB    HighlightTag = "healing_pb"     // récord personal de curación
+)
+
+// HighlightTags son todas las etiquetas de partida destacada, en el orden en que se evalúan
+var HighlightTags = []HighlightTag{
+	HighlightKills,
+	HighlightDeathless,
+	HighlightGPM,
+	HighlightComeback,
+	HighlightLongestGame,
+	HighlightWinStreak,
+	HighlightLossStreak,
+	HighlightHeroDamagePB,
+	HighlightHealingPB,
+}
+
+// Umbrales de las reglas de partida destacada
+const (
+	highlightKillsMin   = 20
+	highlightGPMMin     = 1000 // estrictamente mayor
+	highlightStreakMin  = 10
+	highlightMonthSecs  = 30 * 24 * 60 * 60
+	highlightMinHistory = 5 // partidas previas mínimas para récords personales y partida más larga
+)
+
+// Highlight es una regla que se cumplió en una partida, con los datos para su texto (ver Text)
+type Highlight struct {
+	Tag   HighlightTag
+	Value int // kills, GPM, duración en segundos, partidas de la racha o el nuevo récord según la regla
+	Games int // partidas comparadas en los récords personales
+}
+
+// Text devuelve el texto a mostrar en lang (clave highlight.<tag>)
+func (h Highlight) Text(lang i18n.Lang) string {
+	switch h.Tag {
+	case HighlightDeathless, HighlightComeback:
+		return i18n.T(lang, "highlight."+string(h.Tag))
+	case HighlightLongestGame:
+		return i18n.T(lang, "highlight."+string(h.Tag), FormatDuration(h.Value))
+	case HighlightHeroDamagePB, HighlightHealingPB:
+		return i18n.T(lang, "highlight."+string(h.Tag), h.Value, h.Games)
+	default:
+		return i18n.T(lang, "highlight."+string(h.Tag), h.Value)
+	}
+}
+
+// HighlightContext es la información extra que necesitan las reglas que comparan con el historial del jugador
+type HighlightContext struct {
+	SteamAccountID int64
+	Recent         []StratzMatch // partidas recientes del jugador (más reciente primero); la propia partida se ignora
+}
+
+// highlightRule evalúa una regla; devuelve ok=false si la partida no la cumple
+type highlightRule func(match *MatchResponse, player *Player, ctx *highlightHistory) (Highlight, bool)
+
+// highlightHistory son las partidas previas del jugador (sin la partida evaluada)
+type highlightHistory struct {
+	steamAccountID int64
+	all            []StratzMatch // incluye la partida evaluada si vino en Recent (para rachas)
+	previous       []StratzMatch
+}
+
+var highlightRules = []highlightRule{
+	ruleKills,
+	ruleDeathless,
+	ruleGPM,
+	ruleComeback,
+	ruleLongestGame,
+	ruleStreak,
+	ruleHeroDamagePB,
+	ruleHealingPB,
+}
+
+// DetectHighlights evalúa todas las reglas sobre la partida desde la perspectiva de player
+func DetectHighlights(match *MatchResponse, player *Player, ctx HighlightContext) []Highlight {
+	if match == nil || player == nil {
+		return nil
+	}
+	history := &highlightHistory{steamAccountID: ctx.SteamAccountID, all: ctx.Recent}
+	for _, m := range ctx.Recent {
+		if m.ID != match.MatchID {
+			history.previous = append(history.previous, m)
+		}
+	}
+	var out []Highlight
+	for _, rule := range highlightRules {
+		if h, ok := rule(match, player, history); ok {
+			out = append(out, h)
+		}
+	}
+	return out
+}
+
+// HasHighlight indica si highlights incluye la etiqueta tag
+func HasHighlight(highlights []Highlight, tag HighlightTag) bool {
+	for _, h := range highlights {
+		if h.Tag == tag {
+			return true
+		}
+	}
+	return false
+}
+
+func ruleKills(_ *MatchResponse, p *Player, _ *highlightHistory) (Highlight, bool) {
+	if p.Kills < highlightKillsMin {
+		return Highlight{}, false
+	}
+	return Highlight{Tag: HighlightKills, Value: p.Kills}, true
+}
+
+func ruleDeathless(_ *MatchResponse, p *Player, _ *highlightHistory) (Highlight, bool) {
+	if p.Deaths != 0 {
+		return Highlight{}, false
+	}
+	return Highlight{Tag: HighlightDeathless}, true
+}
+
+func ruleGPM(_ *MatchResponse, p *Player, _ *highlightHistory) (Highlight, bool) {
+	if p.GoldPerMin <= highlightGPMMin {
+		return Highlight{}, false
+	}
+	return Highlight{Tag: HighlightGPM, Value: p.GoldPerMin}, true
+}
+
+func ruleComeback(m *MatchResponse, p *Player, _ *highlightHistory) (Highlight, bool) {
+	if m.RadiantWin == nil || p.IsRadiant == nil || *m.RadiantWin != *p.IsRadiant {
+		return Highlight{}, false
+	}
+	isRadiant := *p.IsRadiant
+	outcome := strings.ToUpper(playerLaneOutcome(p.Lane, isRadiant, m.TopLaneOutcome, m.MidLaneOutcome, m.BottomLaneOutcome))
+	enemyStomp := "RADIANT_STOMP"
+	if isRadiant {
+		enemyStomp = "DIRE_STOMP"
+	}
+	if outcome != enemyStomp {
+		return Highlight{}, false
+	}
+	return Highlight{Tag: HighlightComeback}, true
+}
+
+func ruleLongestGame(m *MatchResponse, _ *Player, h *highlightHistory) (Highlight, bool) {
+	monthGames := 0
+	for _, prev := range h.previous {
+		if m.StartTime-prev.StartDateTime > highlightMonthSecs || prev.StartDateTime > m.StartTime {
+			continue
+		}
+		monthGames++
+		if prev.DurationSeconds >= m.Duration {
+			return Highlight{}, false
+		}
+	}
+	if monthGames < highlightMinHistory {
+		return Highlight{}, false
+	}
+	return Highlight{Tag: HighlightLongestGame, Value: m.Duration}, true
+}
+
+func ruleStreak(m *MatchResponse, p *Player, h *highlightHistory) (Highlight, bool) {
+	// La racha debe incluir la partida evaluada: si Stratz aún no la devuelve, se antepone
+	matches := h.all
+	if len(matches) == 0 || matches[0].ID != m.MatchID {
+		if m.RadiantWin == nil || p.IsRadiant == nil {
+			return Highlight{}, false
+		}
+		current := StratzMatch{
+			ID:            m.MatchID,
+			DidRadiantWin: *m.RadiantWin,
+			Players:       []StratzPlayer{{SteamAccountID: h.steamAccountID, IsRadiant: *p.IsRadiant}},
+		}
+		matches = append([]StratzMatch{current}, h.previous...)
+	}
+	streak := AnalyzeStreakFromStratzMatches(matches, h.steamAccountID)
+	if streak.StreakCount < highlightStreakMin {
+		return Highlight{}, false
+	}
+	if streak.IsWinStreak {
+		return Highlight{Tag: HighlightWinStreak, Value: streak.StreakCount}, true
+	}
+	return Highlight{Tag: HighlightLossStreak, Value: streak.StreakCount}, true
+}
+
+func ruleHeroDamagePB(_ *MatchResponse, p *Player, h *highlightHistory) (Highlight, bool) {
+	best, games := h.personalBest(func(sp *StratzPlayer) int { return sp.HeroDamage })
+	if games < highlightMinHistory || p.HeroDamage <= best {
+		return Highlight{}, false
+	}
+	return Highlight{Tag: HighlightHeroDamagePB, Value: p.HeroDamage, Games: games + 1}, true
+}
+
+func ruleHealingPB(_ *MatchResponse, p *Player, h *highlightHistory) (Highlight, bool) {
+	if p.HeroHealing == 0 {
+		return Highlight{}, false
+	}
+	best, games := h.personalBest(func(sp *StratzPlayer) int { return sp.HeroHealing })
+	if games < highlightMinHistory || p.HeroHealing <= best {
+		return Highlight{}, false
+	}
+	return Highlight{Tag: HighlightHealingPB, Value: p.HeroHealing, Games: games + 1}, true
+}
+
+// personalBest devuelve el máximo de value en las partidas previas y cuántas partidas se compararon
+func (h *highlightHistory) personalBest(value func(*StratzPlayer) int) (best, games int) {
+	for i := range h.previous {
+		sp := FindStratzPlayer(&h.previous[i], h.steamAccountID)
+		if sp == nil {
+			continue
+		}
+		games++
+		if v := value(sp); v > best {
+			best = v
+		}
+	}
+	return best, games
+}
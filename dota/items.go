@@ -0,0 +1,92 @@
+package dota
+
+import (
+	"encoding/json"
+	"fmt"
+	"sort"
+)
+
+const (
+	// Steam CDN para iconos de items: https://cdn.steamstatic.com/apps/dota2/images/dota_react/items/{name}.png
+	steamCDNItems = "https://cdn.steamstatic.com/apps/dota2/images/dota_react/items"
+
+	// keyItemMinCost es el coste mínimo para considerar un item "grande" en los timings
+	keyItemMinCost = 2000
+)
+
+// Item representa un item (dota/items.json, mismo formato que las constantes de OpenDota)
+type Item struct {
+	ID    int    `json:"id"`
+	Name  string `json:"-"` // clave del JSON, ej. "black_king_bar"
+	DName string `json:"dname"`
+	Img   string `json:"img"`
+	Qual  string `json:"qual"` // component, consumable, common…
+	Cost  int    `json:"cost"`
+	Tier  int    `json:"tier"` // tier de objeto neutral (0 = no es neutral)
+}
+
+// CheckItems comprueba que data es el items.json completo de OpenDota y no un subconjunto: el campo 🌿 de las
+// notificaciones necesita los objetos neutrales (los que tienen tier). Devuelve cuántos neutrales hay.
+func CheckItems(data []byte) (int, error) {
+	var items map[string]Item
+	if err := json.Unmarshal(data, &items); err != nil {
+		return 0, err
+	}
+	return checkNeutralItems(items)
+}
+
+func checkNeutralItems(items map[string]Item) (int, error) {
+	neutrals := 0
+	for _, item := range items {
+		if item.Tier > 0 {
+			neutrals++
+		}
+	}
+	if neutrals == 0 {
+		return 0, fmt.Errorf("%d items sin ningún objeto neutral (regenera items.json con make update-constants)", len(items))
+	}
+	return neutrals, nil
+}
+
+// ItemPurchase es la compra de un item en una partida parseada
+type ItemPurchase struct {
+	Time   int `json:"time"` // segundos desde el inicio (negativo = antes del horn)
+	ItemID int `json:"item_id"`
+}
+
+// GetItemName retorna el nombre del item (ej. "Black King Bar"), o "Item N" si no está en items.json
+func (c *Client) GetItemName(itemID int) string {
+	if item, ok := c.constants.Item(itemID); ok && item.DName != "" {
+		return item.DName
+	}
+	return fmt.Sprintf("Item %d", itemID)
+}
+
+// GetItemImageURL retorna la URL del icono del item desde Steam CDN ("" si no está en items.json)
+func (c *Client) GetItemImageURL(itemID int) string {
+	if item, ok := c.constants.Item(itemID); ok && item.Name != "" {
+		return fmt.Sprintf("%s/%s.png", steamCDNItems, item.Name)
+	}
+	return ""
+}
+
+// KeyItemTimings devuelve las primeras n compras de items grandes (coste ≥ keyItemMinCost, sin componentes
+// ni consumibles), una por item, ordenadas por tiempo
+func (c *Client) KeyItemTimings(purchases []ItemPurchase, n int) []ItemPurchase {
+	sorted := append([]ItemPurchase(nil), purchases...)
+	sort.SliceStable(sorted, func(i, j int) bool { return sorted[i].Time < sorted[j].Time })
+	seen := make(map[int]bool)
+	var out []ItemPurchase
+	for _, p := range sorted {
+		item, ok := c.constants.Item(p.ItemID)
+		if !ok || seen[p.ItemID] || item.Cost < keyItemMinCost || item.Qual == "component" || item.Qual == "consumable" {
+			continue
+		}
+		seen[p.ItemID] = true
+		out = append(out, p)
+		if n > 0 && len(out) >= n {
+			break
+		}
+	}
+	return out
+}
@@ -0,0 +1,100 @@
+package dota
+
+import (
+	"dota-discord-bot/i18n"
+	"sort"
+	"strings"
+)
+
+// PerformanceWeights son los pesos (suman 1) de cada métrica en la puntuación de rendimiento
+type PerformanceWeights struct {
+	KDA         float64
+	HeroDamage  float64
+	TowerDamage float64
+	Healing     float64
+	GPM         float64
+}
+
+// Pesos por rol: los cores se miden más por daño/farm, los supports por KDA y curación
+var (
+	CorePerformanceWeights    = PerformanceWeights{KDA: 0.30, HeroDamage: 0.25, TowerDamage: 0.15, Healing: 0.05, GPM: 0.25}
+	SupportPerformanceWeights = PerformanceWeights{KDA: 0.35, HeroDamage: 0.20, TowerDamage: 0.05, Healing: 0.25, GPM: 0.15}
+)
+
+// PlayerScore es la puntuación de rendimiento (0-100) de un jugador en una partida
+type PlayerScore struct {
+	Player Player
+	Score  float64
+}
+
+// IsSupportRole indica si el rol de Stratz es de support (LIGHT_SUPPORT, HARD_SUPPORT)
+func IsSupportRole(role string) bool {
+	return strings.Contains(strings.ToUpper(role), "SUPPORT")
+}
+
+// playerKDA devuelve (K+A)/max(D,1)
+func playerKDA(p *Player) float64 {
+	deaths := p.Deaths
+	if deaths < 1 {
+		deaths = 1
+	}
+	return float64(p.Kills+p.Assists) / float64(deaths)
+}
+
+// ScorePlayers puntúa a todos los jugadores de la partida y los devuelve de mejor a peor.
+// Cada métrica se normaliza dividiendo por el máximo de la partida (0-1) y se pondera según el rol del jugador
+// (SupportPerformanceWeights para supports, CorePerformanceWeights para el resto); la suma se escala a 0-100.
+func ScorePlayers(match *MatchResponse) []PlayerScore {
+	if match == nil || len(match.Players) == 0 {
+		return nil
+	}
+	var maxKDA, maxHeroDamage, maxTowerDamage, maxHealing, maxGPM float64
+	for i := range match.Players {
+		p := &match.Players[i]
+		maxKDA = max(maxKDA, playerKDA(p))
+		maxHeroDamage = max(maxHeroDamage, float64(p.HeroDamage))
+		maxTowerDamage = max(maxTowerDamage, float64(p.TowerDamage))
+		maxHealing = max(maxHealing, float64(p.HeroHealing))
+		maxGPM = max(maxGPM, float64(p.GoldPerMin))
+	}
+	ratio := func(v, maxV float64) float64 {
+		if maxV <= 0 {
+			return 0
+		}
+		return v / maxV
+	}
+
+	scores := make([]PlayerScore, 0, len(match.Players))
+	for i := range match.Players {
+		p := &match.Players[i]
+		w := CorePerformanceWeights
+		if IsSupportRole(p.Role) {
+			w = SupportPerformanceWeights
+		}
+		score := w.KDA*ratio(playerKDA(p), maxKDA) +
+			w.HeroDamage*ratio(float64(p.HeroDamage), maxHeroDamage) +
+			w.TowerDamage*ratio(float64(p.TowerDamage), maxTowerDamage) +
+			w.Healing*ratio(float64(p.HeroHealing), maxHealing) +
+			w.GPM*ratio(float64(p.GoldPerMin), maxGPM)
+		scores = append(scores, PlayerScore{Player: *p, Score: 100 * score})
+	}
+	sort.SliceStable(scores, func(a, b int) bool { return scores[a].Score > scores[b].Score })
+	return scores
+}
+
+// MVPAndWorst devuelve el jugador con mayor y menor puntuación de la partida (ok=false si no hay jugadores)
+func MVPAndWorst(match *MatchResponse) (mvp, worst PlayerScore, ok bool) {
+	scores := ScorePlayers(match)
+	if len(scores) == 0 {
+		return mvp, worst, false
+	}
+	return scores[0], scores[len(scores)-1], true
+}
+
+// ScoringDescription describe en lang el algoritmo de puntuación para el footer del embed
+func ScoringDescription(lang i18n.Lang) string {
+	format := func(w PerformanceWeights) string {
+		return i18n.T(lang, "scoring.weights", 100*w.KDA, 100*w.HeroDamage, 100*w.TowerDamage, 100*w.Healing, 100*w.GPM)
+	}
+	return i18n.T(lang, "scoring.description", format(CorePerformanceWeights), format(SupportPerformanceWeights))
+}
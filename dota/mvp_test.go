@@ -0,0 +1,107 @@
+package dota
+
+import (
+	"math"
+	"testing"
+)
+
+func boolPtr(v bool) *bool { return &v }
+
+func TestScorePlayers(t *testing.T) {
+	tests := []struct {
+		name      string
+		players   []Player
+		wantOrder []int     // AccountID de mejor a peor
+		wantScore []float64 // puntuación en el mismo orden (nil = no comprobar)
+	}{
+		{
+			name: "core máximo en todo",
+			players: []Player{
+				{AccountID: 1, Kills: 10, Deaths: 1, Assists: 10, HeroDamage: 30000, TowerDamage: 5000, HeroHealing: 1000, GoldPerMin: 700, Role: "CORE"},
+				{AccountID: 2, Kills: 5, Deaths: 1, Assists: 5, HeroDamage: 15000, TowerDamage: 2500, HeroHealing: 500, GoldPerMin: 350, Role: "CORE"},
+			},
+			wantOrder: []int{1, 2},
+			wantScore: []float64{100, 50},
+		},
+		{
+			name: "pesos por rol: la curación pesa más en supports",
+			players: []Player{
+				// 1 y 2 solo difieren en el rol: la curación suma 25 al support y 5 al core.
+				// 3 cambia la curación por daño a torres (15 para un core) y queda empatado con el support.
+				{AccountID: 1, Kills: 2, Deaths: 2, Assists: 2, HeroDamage: 10000, TowerDamage: 0, HeroHealing: 8000, GoldPerMin: 300, Role: "CORE"},
+				{AccountID: 2, Kills: 2, Deaths: 2, Assists: 2, HeroDamage: 10000, TowerDamage: 0, HeroHealing: 8000, GoldPerMin: 300, Role: "HARD_SUPPORT"},
+				{AccountID: 3, Kills: 2, Deaths: 2, Assists: 2, HeroDamage: 10000, TowerDamage: 1000, HeroHealing: 0, GoldPerMin: 300, Role: "CORE"},
+			},
+			wantOrder: []int{2, 3, 1},
+			wantScore: []float64{95, 95, 85},
+		},
+		{
+			name: "empate: se conserva el orden de la partida",
+			players: []Player{
+				{AccountID: 7, Kills: 5, Deaths: 5, Assists: 5, HeroDamage: 10000, GoldPerMin: 400, Role: "CORE"},
+				{AccountID: 3, Kills: 5, Deaths: 5, Assists: 5, HeroDamage: 10000, GoldPerMin: 400, Role: "CORE"},
+			},
+			wantOrder: []int{7, 3},
+		},
+		{
+			name: "MVP en el equipo perdedor",
+			players: []Player{
+				{AccountID: 1, IsRadiant: boolPtr(true), Kills: 4, Deaths: 6, Assists: 8, HeroDamage: 12000, GoldPerMin: 420, Role: "CORE"},
+				{AccountID: 2, IsRadiant: boolPtr(false), Kills: 20, Deaths: 2, Assists: 6, HeroDamage: 45000, TowerDamage: 3000, GoldPerMin: 800, Role: "CORE"},
+			},
+			wantOrder: []int{2, 1},
+		},
+		{
+			name: "sin GPM ni daño (partida sin parsear): no hay NaN",
+			players: []Player{
+				{AccountID: 1, Kills: 6, Deaths: 1, Assists: 3, Role: "CORE"},
+				{AccountID: 2, Kills: 0, Deaths: 8, Assists: 1, Role: "CORE"},
+			},
+			wantOrder: []int{1, 2},
+			wantScore: []float64{30, 30 * (1.0 / 8) / 9},
+		},
+	}
+	for _, tt := range tests {
+		radiantWin := true
+		scores := ScorePlayers(&MatchResponse{RadiantWin: &radiantWin, Players: tt.players})
+		if len(scores) != len(tt.wantOrder) {
+			t.Fatalf("%s: %d puntuaciones, se esperaban %d", tt.name, len(scores), len(tt.wantOrder))
+		}
+		for i, score := range scores {
+			if math.IsNaN(score.Score) || score.Score < 0 || score.Score > 100 {
+				t.Errorf("%s: puntuación fuera de rango para %d: %v", tt.name, score.Player.AccountID, score.Score)
+			}
+			if score.Player.AccountID != tt.wantOrder[i] {
+				t.Errorf("%s: puesto %d = %d, se esperaba %d", tt.name, i+1, score.Player.AccountID, tt.wantOrder[i])
+			}
+			if tt.wantScore != nil && math.Abs(score.Score-tt.wantScore[i]) > 0.01 {
+				t.Errorf("%s: puntuación de %d = %.2f, se esperaba %.2f", tt.name, score.Player.AccountID, score.Score, tt.wantScore[i])
+			}
+		}
+	}
+}
+
+func TestMVPAndWorst(t *testing.T) {
+	if _, _, ok := MVPAndWorst(nil); ok {
+		t.Error("MVPAndWorst(nil): ok = true")
+	}
+	if _, _, ok := MVPAndWorst(&MatchResponse{}); ok {
+		t.Error("MVPAndWorst sin jugadores: ok = true")
+	}
+
+	match := &MatchResponse{Players: []Player{
+		{AccountID: 1, Kills: 3, Deaths: 7, Assists: 4, HeroDamage: 9000, GoldPerMin: 350, Role: "CORE"},
+		{AccountID: 2, Kills: 15, Deaths: 2, Assists: 9, HeroDamage: 38000, TowerDamage: 6000, GoldPerMin: 750, Role: "CORE"},
+		{AccountID: 3, Kills: 1, Deaths: 9, Assists: 2, HeroDamage: 3000, GoldPerMin: 220, Role: "LIGHT_SUPPORT"},
+	}}
+	mvp, worst, ok := MVPAndWorst(match)
+	if !ok || mvp.Player.AccountID != 2 || worst.Player.AccountID != 3 {
+		t.Errorf("MVPAndWorst = %d/%d (ok=%v), se esperaba 2/3", mvp.Player.AccountID, worst.Player.AccountID, ok)
+	}
+
+	solo := &MatchResponse{Players: []Player{{AccountID: 5, Kills: 1, Role: "CORE"}}}
+	mvp, worst, ok = MVPAndWorst(solo)
+	if !ok || mvp.Player.AccountID != 5 || worst.Player.AccountID != 5 {
+		t.Errorf("MVPAndWorst con un jugador = %d/%d (ok=%v)", mvp.Player.AccountID, worst.Player.AccountID, ok)
+	}
+}
@@ -0,0 +1,29 @@
+package dota
+
+// RankStars devuelve las estrellas (1-5) de un seasonRank de Stratz (ej. 54 = Legend 4 -> 4).
+// Devuelve 0 si no hay rango o es Immortal.
+func RankStars(rank int) int {
+	if rank <= 0 || rank >= 80 {
+		return 0
+	}
+	return rank % 10
+}
+
+// RankSteps convierte el rango en una escala lineal de estrellas (Herald 1 = 1 … Divine 5 = 35, Immortal = 36)
+// para comparar rangos y medir cuánto subió o bajó un jugador. Sin seasonRank usa solo la medalla.
+func RankSteps(bracket string, rank int) int {
+	if rank >= 80 {
+		return 36
+	}
+	if rank > 0 {
+		return (rank/10-1)*5 + rank%10
+	}
+	order := RankBracketOrder(bracket)
+	if order == 0 {
+		return 0
+	}
+	if order == 8 {
+		return 36
+	}
+	return (order-1)*5 + 1
+}
@@ -0,0 +1,75 @@
+package dota
+
+// GameHighlight es una partida concreta de un jugador (mejor/peor partida del recap)
+type GameHighlight struct {
+	MatchID        int64
+	SteamAccountID int64
+	HeroID         int
+	Kills          int
+	Deaths         int
+	Assists        int
+	Won            bool
+	KDA            float64
+}
+
+// PlayerRecap resume a un jugador en un periodo (recap semanal/mensual)
+type PlayerRecap struct {
+	Summary          PlayerMatchSummary
+	Best             GameHighlight // partida con mayor KDA
+	Worst            GameHighlight // partida con menor KDA
+	LongestStreak    int
+	LongestStreakWin bool
+}
+
+// PlayerKDA devuelve (K+A)/D de una partida (K+A si no murió)
+func PlayerKDA(p *StratzPlayer) float64 {
+	if p.Deaths == 0 {
+		return float64(p.Kills + p.Assists)
+	}
+	return float64(p.Kills+p.Assists) / float64(p.Deaths)
+}
+
+// BuildPlayerRecap resume las partidas de steamAccountID: totales, mejor y peor partida por KDA y racha más larga.
+// Summary.Games es 0 si el jugador no aparece en ninguna partida.
+func BuildPlayerRecap(matches []StratzMatch, steamAccountID int64) PlayerRecap {
+	recap := PlayerRecap{Summary: SummarizePlayerMatches(matches, steamAccountID)}
+	streak := 0
+	var streakWin bool
+	found := false
+	for i := range matches {
+		m := &matches[i]
+		p := FindStratzPlayer(m, steamAccountID)
+		if p == nil {
+			continue
+		}
+		game := GameHighlight{
+			MatchID:        m.ID,
+			SteamAccountID: steamAccountID,
+			HeroID:         p.HeroID,
+			Kills:          p.Kills,
+			Deaths:         p.Deaths,
+			Assists:        p.Assists,
+			Won:            IsStratzPlayerWin(m, p),
+			KDA:            PlayerKDA(p),
+		}
+		if !found || game.KDA > recap.Best.KDA {
+			recap.Best = game
+		}
+		if !found || game.KDA < recap.Worst.KDA {
+			recap.Worst = game
+		}
+		found = true
+
+		if streak > 0 && game.Won == streakWin {
+			streak++
+		} else {
+			streak = 1
+			streakWin = game.Won
+		}
+		if streak > recap.LongestStreak {
+			recap.LongestStreak = streak
+			recap.LongestStreakWin = streakWin
+		}
+	}
+	return recap
+}
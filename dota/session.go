@@ -0,0 +1,73 @@
+package dota
+
+// SessionGap es el hueco máximo (segundos) entre el final de una partida y el inicio de la siguiente para que
+// formen parte de la misma sesión de juego
+const SessionGap = 45 * 60
+
+// SessionSummary resume la sesión de un jugador
+type SessionSummary struct {
+	Summary   PlayerMatchSummary
+	MatchIDs  []int64       // más reciente primero
+	Start     int64         // unix de inicio de la primera partida
+	End       int64         // unix de fin de la última partida
+	Duration  int           // segundos jugados (suma de duraciones)
+	Best      GameHighlight // partida con mayor puntuación de rendimiento (ScorePlayers)
+	BestScore float64
+}
+
+// MatchEnd devuelve el unix en que terminó la partida
+func MatchEnd(m *StratzMatch) int64 {
+	return m.StartDateTime + int64(m.DurationSeconds)
+}
+
+// LatestSession devuelve la última sesión de matches (más reciente primero, como GetPlayerRecentMatches): la partida
+// más reciente y las anteriores mientras el hueco entre partidas consecutivas sea menor que SessionGap.
+func LatestSession(matches []StratzMatch) []StratzMatch {
+	if len(matches) == 0 {
+		return nil
+	}
+	n := 1
+	for n < len(matches) && matches[n-1].StartDateTime-MatchEnd(&matches[n]) < SessionGap {
+		n++
+	}
+	return matches[:n]
+}
+
+// SummarizeSession resume las partidas de la sesión de steamAccountID (más reciente primero): totales, tiempo jugado
+// y mejor partida según la puntuación de rendimiento del MVP.
+func SummarizeSession(session []StratzMatch, steamAccountID int64) SessionSummary {
+	out := SessionSummary{Summary: SummarizePlayerMatches(session, steamAccountID)}
+	for i := range session {
+		m := &session[i]
+		p := FindStratzPlayer(m, steamAccountID)
+		if p == nil {
+			continue
+		}
+		out.MatchIDs = append(out.MatchIDs, m.ID)
+		out.Duration += m.DurationSeconds
+		if out.Start == 0 || m.StartDateTime < out.Start {
+			out.Start = m.StartDateTime
+		}
+		out.End = max(out.End, MatchEnd(m))
+
+		for _, score := range ScorePlayers(StratzMatchToMatchResponse(m)) {
+			if int64(score.Player.AccountID) != steamAccountID {
+				continue
+			}
+			if len(out.MatchIDs) == 1 || score.Score > out.BestScore {
+				out.BestScore = score.Score
+				out.Best = GameHighlight{
+					MatchID:        m.ID,
+					SteamAccountID: steamAccountID,
+					HeroID:         p.HeroID,
+					Kills:          p.Kills,
+					Deaths:         p.Deaths,
+					Assists:        p.Assists,
+					Won:            IsStratzPlayerWin(m, p),
+					KDA:            PlayerKDA(p),
+				}
+			}
+		}
+	}
+	return out
+}
@@ -69,7 +69,7 @@ type StratzMatch struct {
 	DurationSeconds   int              `json:"durationSeconds"`
 	StartDateTime     int64            `json:"startDateTime"`
 	GameMode          stratzIntOrStr   `json:"gameMode"`       // Stratz puede devolver int o string (enum)
-	LobbyType         stratzIntOrStr   `json:"lobbyType"`      // Stratz puede devolver int o string (enum)
+	LobbyType         stratzLobbyType  `json:"lobbyType"`      // Stratz puede devolver int o string (enum)
 	RadiantKills      stratzIntOrArray `json:"radiantKills"`   // Stratz puede devolver int o array
 	DireKills         stratzIntOrArray `json:"direKills"`      // Stratz puede devolver int o array
 	ParsedDateTime    *int64           `json:"parsedDateTime"` // Long; si no es null y > 0, la partida está parseada
@@ -77,6 +77,9 @@ type StratzMatch struct {
 	TopLaneOutcome    string           `json:"topLaneOutcome"`    // TIE, RADIANT_VICTORY, RADIANT_STOMP, DIRE_VICTORY, DIRE_STOMP
 	MidLaneOutcome    string           `json:"midLaneOutcome"`    // idem
 	BottomLaneOutcome string           `json:"bottomLaneOutcome"` // idem
+	// Ventaja de Radiant por minuto (solo GetMatch, partidas parseadas; negativo = ventaja Dire)
+	RadiantNetworthLeads   []int `json:"radiantNetworthLeads"`
+	RadiantExperienceLeads []int `json:"radiantExperienceLeads"`
 }
 
 // stratzIntOrArray acepta radiantKills/direKills como int o array desde la API (array = suma de elementos; API devuelve kills por minuto)
@@ -139,52 +142,90 @@ var stratzGameModeToID = map[string]int{
 	"TURBO": 23, "MUTATION": 24,
 }
 
-// stratzIntOrStr acepta gameMode/lobbyType como int o string (enum) desde la API
+// stratzLobbyTypeToID mapea Stratz LobbyTypeEnum a Dota lobby_type ID (lobby_type.json)
+var stratzLobbyTypeToID = map[string]int{
+	"UNRANKED": 0, "PRACTICE": 1, "TOURNAMENT": 2, "TUTORIAL": 3, "COOP_VS_BOTS": 4,
+	"TEAM_MATCH": 5, "SOLO_QUEUE": 6, "RANKED": 7, "SOLO_MID": 8, "BATTLE_CUP": 9,
+	"LOCAL_BOTS": 10, "SPECTATOR": 11, "EVENT": 12, "GAUNTLET": 13, "NEW_PLAYER": 14,
+	"FEATURED": 15,
+}
+
+// stratzIntOrStr acepta gameMode como int o string (enum GameModeEnumType) desde la API
 type stratzIntOrStr int
 
 func (s *stratzIntOrStr) UnmarshalJSON(data []byte) error {
+	n, err := decodeStratzEnum(data, stratzGameModeToID)
+	*s = stratzIntOrStr(n)
+	return err
+}
+
+// stratzLobbyType acepta lobbyType como int o string (enum LobbyTypeEnum) desde la API
+type stratzLobbyType int
+
+func (s *stratzLobbyType) UnmarshalJSON(data []byte) error {
+	n, err := decodeStratzEnum(data, stratzLobbyTypeToID)
+	*s = stratzLobbyType(n)
+	return err
+}
+
+// decodeStratzEnum devuelve el ID de un campo que Stratz envía como número, número entre comillas o enum de ids
+// (0 si el enum es desconocido).
+func decodeStratzEnum(data []byte, ids map[string]int) (int, error) {
 	if len(data) >= 2 && data[0] == '"' && data[len(data)-1] == '"' {
 		var str string
 		if err := json.Unmarshal(data, &str); err != nil {
-			return err
+			return 0, err
 		}
 		var n int
 		if _, err := fmt.Sscanf(str, "%d", &n); err == nil {
-			*s = stratzIntOrStr(n)
-			return nil
-		}
-		if id, ok := stratzGameModeToID[str]; ok {
-			*s = stratzIntOrStr(id)
-			return nil
+			return n, nil
 		}
-		*s = 0
-		return nil
+		return ids[str], nil
 	}
 	var n int
 	if err := json.Unmarshal(data, &n); err != nil {
-		return err
+		return 0, err
 	}
-	*s = stratzIntOrStr(n)
-	return nil
+	return n, nil
 }
 
 // StratzPlayer representa un jugador en una partida de Stratz
 type StratzPlayer struct {
-	SteamAccountID      int64               `json:"steamAccountId"`
-	IsRadiant           bool                `json:"isRadiant"`
-	HeroID              int                 `json:"heroId"`
-	Kills               int                 `json:"kills"`
-	Deaths              int                 `json:"deaths"`
-	Assists             int                 `json:"assists"`
-	Level               int                 `json:"level"`
-	GoldPerMinute       int                 `json:"goldPerMinute"`
-	ExperiencePerMinute int                 `json:"experiencePerMinute"`
-	HeroDamage          int                 `json:"heroDamage"`
-	TowerDamage         int                 `json:"towerDamage"`
-	HeroHealing         int                 `json:"heroHealing"`
-	Lane                string              `json:"lane"` // enum SAFE_LANE, MID_LANE, OFF_LANE o vacío
-	Role                string              `json:"role"` // enum CORE, SUPPORT o vacío
-	SteamAccount        *StratzSteamAccount `json:"steamAccount"`
+	SteamAccountID      int64                    `json:"steamAccountId"`
+	IsRadiant           bool                     `json:"isRadiant"`
+	HeroID              int                      `json:"heroId"`
+	Kills               int                      `json:"kills"`
+	Deaths              int                      `json:"deaths"`
+	Assists             int                      `json:"assists"`
+	Level               int                      `json:"level"`
+	GoldPerMinute       int                      `json:"goldPerMinute"`
+	ExperiencePerMinute int                      `json:"experiencePerMinute"`
+	HeroDamage          int                      `json:"heroDamage"`
+	TowerDamage         int                      `json:"towerDamage"`
+	HeroHealing         int                      `json:"heroHealing"`
+	Networth            int                      `json:"networth"` // patrimonio neto al final de la partida
+	Lane                string                   `json:"lane"`     // enum SAFE_LANE, MID_LANE, OFF_LANE o vacío
+	Role                string                   `json:"role"`     // enum CORE, SUPPORT o vacío
+	Item0ID             int                      `json:"item0Id"`
+	Item1ID             int                      `json:"item1Id"`
+	Item2ID             int                      `json:"item2Id"`
+	Item3ID             int                      `json:"item3Id"`
+	Item4ID             int                      `json:"item4Id"`
+	Item5ID             int                      `json:"item5Id"`
+	Backpack0ID         int                      `json:"backpack0Id"`
+	Backpack1ID         int                      `json:"backpack1Id"`
+	Backpack2ID         int                      `json:"backpack2Id"`
+	Neutral0ID          int                      `json:"neutral0Id"`
+	Stats               *StratzPlayerStatsDetail `json:"stats"` // solo en GetMatch; null si la partida no está parseada
+	SteamAccount        *StratzSteamAccount      `json:"steamAccount"`
+}
+
+// StratzPlayerStatsDetail son las estadísticas detalladas de un jugador en una partida parseada
+type StratzPlayerStatsDetail struct {
+	ItemPurchases []struct {
+		Time   int `json:"time"`
+		ItemID int `json:"itemId"`
+	} `json:"itemPurchases"`
 }
 
 // StratzSteamAccount representa la cuenta de Steam de un jugador
@@ -203,6 +244,7 @@ type StratzPlayerStats struct {
 	WinCount       int    `json:"winCount"`
 	MatchCount     int    `json:"matchCount"`
 	RankBracket    string `json:"rankBracket"` // UNCALIBRATED, HERALD, GUARDIAN, CRUSADER, ARCHON, LEGEND, ANCIENT, DIVINE, IMMORTAL
+	Rank           int    `json:"rank"`        // seasonRank: decenas = medalla, unidades = estrellas (ej. 54 = Legend 4); 0 si no hay
 }
 
 // StratzHeroStats representa las estadísticas de un héroe para un jugador
@@ -320,6 +362,8 @@ func (c *StratzClient) GetMatch(matchID int64) (*StratzMatch, error) {
 				topLaneOutcome
 				midLaneOutcome
 				bottomLaneOutcome
+				radiantNetworthLeads
+				radiantExperienceLeads
 				players {
 					steamAccountId
 					isRadiant
@@ -335,6 +379,23 @@ func (c *StratzClient) GetMatch(matchID int64) (*StratzMatch, error) {
 					heroDamage
 					towerDamage
 					heroHealing
+					networth
+					item0Id
+					item1Id
+					item2Id
+					item3Id
+					item4Id
+					item5Id
+					backpack0Id
+					backpack1Id
+					backpack2Id
+					neutral0Id
+					stats {
+						itemPurchases {
+							time
+							itemId
+						}
+					}
 					steamAccount {
 						id
 						name
@@ -357,12 +418,17 @@ func (c *StratzClient) GetMatch(matchID int64) (*StratzMatch, error) {
 	return result.Match, nil
 }
 
-// GetPlayerRecentMatches obtiene las partidas recientes de un jugador (incluye parseStatus por partida)
+// GetPlayerRecentMatches obtiene las partidas recientes de un jugador (incluye lane/role y lane outcomes por partida)
 func (c *StratzClient) GetPlayerRecentMatches(steamAccountID int64, limit int) ([]StratzMatch, error) {
+	return c.GetPlayerRecentMatchesFiltered(steamAccountID, limit, MatchFilter{})
+}
+
+// GetPlayerRecentMatchesFiltered es GetPlayerRecentMatches con filtros de Stratz (rol, línea, modo, lobby, fechas).
+func (c *StratzClient) GetPlayerRecentMatchesFiltered(steamAccountID int64, limit int, filter MatchFilter) ([]StratzMatch, error) {
 	query := `
 		query GetPlayerMatches($steamAccountId: Long!, $take: Int!) {
 			player(steamAccountId: $steamAccountId) {
-				matches(request: { take: $take }) {
+				matches(request: { take: $take` + filter.requestFields() + ` }) {
 					id
 					didRadiantWin
 					durationSeconds
@@ -371,10 +437,15 @@ func (c *StratzClient) GetPlayerRecentMatches(steamAccountID int64, limit int) (
 					lobbyType
 					radiantKills
 					direKills
+					topLaneOutcome
+					midLaneOutcome
+					bottomLaneOutcome
 					players {
 						steamAccountId
 						isRadiant
 						heroId
+						lane
+						role
 						kills
 						deaths
 						assists
@@ -500,6 +571,7 @@ func (c *StratzClient) GetPlayerProfile(steamAccountID int64) (*StratzPlayerStat
 				matchCount
 				ranks(seasonRankIds: [0]) {
 					rankBracket
+					rank
 				}
 			}
 		}
@@ -517,6 +589,7 @@ func (c *StratzClient) GetPlayerProfile(steamAccountID int64) (*StratzPlayerStat
 			MatchCount int `json:"matchCount"`
 			Ranks      []struct {
 				RankBracket string `json:"rankBracket"`
+				Rank        int    `json:"rank"`
 			} `json:"ranks"`
 		} `json:"player"`
 	}
@@ -526,8 +599,10 @@ func (c *StratzClient) GetPlayerProfile(steamAccountID int64) (*StratzPlayerStat
 	}
 
 	rankBracket := ""
-	if len(result.Player.Ranks) > 0 && result.Player.Ranks[0].RankBracket != "" {
+	rank := 0
+	if len(result.Player.Ranks) > 0 {
 		rankBracket = result.Player.Ranks[0].RankBracket
+		rank = result.Player.Ranks[0].Rank
 	}
 
 	avatar := NormalizeSteamAvatarURL(result.Player.SteamAccount.Avatar)
@@ -539,6 +614,7 @@ func (c *StratzClient) GetPlayerProfile(steamAccountID int64) (*StratzPlayerStat
 		WinCount:       result.Player.WinCount,
 		MatchCount:     result.Player.MatchCount,
 		RankBracket:    rankBracket,
+		Rank:           rank,
 	}, nil
 }
 
@@ -623,13 +699,18 @@ func (c *StratzClient) GetMultiplePlayersWinLoss(steamAccountIDs []int64, limit
 // GetPlayerHeroStats obtiene W/L por héroe en las últimas take partidas (sin filtro de parche).
 // take: 1-100 (Stratz impone máx. 100). Solo devuelve héroes con al menos minGames partidas. Ordenado por partidas jugadas (desc).
 func (c *StratzClient) GetPlayerHeroStats(steamAccountID int64, minGames, take int) ([]StratzHeroStats, error) {
+	return c.GetPlayerHeroStatsFiltered(steamAccountID, minGames, take, MatchFilter{})
+}
+
+// GetPlayerHeroStatsFiltered es GetPlayerHeroStats aplicando filter en la query de Stratz (ver MatchFilter, PatchFilter).
+func (c *StratzClient) GetPlayerHeroStatsFiltered(steamAccountID int64, minGames, take int, filter MatchFilter) ([]StratzHeroStats, error) {
 	if take <= 0 {
 		take = 100
 	}
 	if take > 100 {
 		take = 100
 	}
-	matches, err := c.GetPlayerRecentMatches(steamAccountID, take)
+	matches, err := c.GetPlayerRecentMatchesFiltered(steamAccountID, take, filter)
 	if err != nil {
 		return nil, err
 	}
@@ -694,12 +775,15 @@ func StratzMatchToMatchResponse(m *StratzMatch) *MatchResponse {
 		StartTime:         m.StartDateTime,
 		GameMode:          int(m.GameMode),
 		LobbyType:         int(m.LobbyType),
+		Parsed:            IsMatchParsed(m),
 		RadiantScore:      radiantScore,
 		DireScore:         direScore,
 		Players:           players,
 		TopLaneOutcome:    m.TopLaneOutcome,
 		MidLaneOutcome:    m.MidLaneOutcome,
 		BottomLaneOutcome: m.BottomLaneOutcome,
+		RadiantGoldAdv:    m.RadiantNetworthLeads,
+		RadiantXPAdv:      m.RadiantExperienceLeads,
 	}
 }
 
@@ -734,33 +818,44 @@ func StratzPlayerToPlayer(sp *StratzPlayer, radiantWin bool, radiantIdx, direIdx
 	} else if sp.Kills+sp.Assists > 0 {
 		kda = float64(sp.Kills + sp.Assists)
 	}
+	var purchases []ItemPurchase
+	if sp.Stats != nil {
+		for _, ip := range sp.Stats.ItemPurchases {
+			purchases = append(purchases, ItemPurchase{Time: ip.Time, ItemID: ip.ItemID})
+		}
+	}
 	return &Player{
-		AccountID:   int(sp.SteamAccountID),
-		PlayerSlot:  playerSlot,
-		HeroID:      sp.HeroID,
-		Kills:       sp.Kills,
-		Deaths:      sp.Deaths,
-		Assists:     sp.Assists,
-		Win:         &win,
-		Lose:        &lose,
-		IsRadiant:   &isRadiant,
-		Personaname: personaname,
-		Level:       sp.Level,
-		GoldPerMin:  sp.GoldPerMinute,
-		XpPerMin:    sp.ExperiencePerMinute,
-		HeroDamage:  sp.HeroDamage,
-		TowerDamage: sp.TowerDamage,
-		HeroHealing: sp.HeroHealing,
-		KDA:         kda,
-		Lane:        sp.Lane,
-		Role:        sp.Role,
+		AccountID:     int(sp.SteamAccountID),
+		PlayerSlot:    playerSlot,
+		HeroID:        sp.HeroID,
+		Kills:         sp.Kills,
+		Deaths:        sp.Deaths,
+		Assists:       sp.Assists,
+		Win:           &win,
+		Lose:          &lose,
+		IsRadiant:     &isRadiant,
+		Personaname:   personaname,
+		Level:         sp.Level,
+		GoldPerMin:    sp.GoldPerMinute,
+		XpPerMin:      sp.ExperiencePerMinute,
+		HeroDamage:    sp.HeroDamage,
+		TowerDamage:   sp.TowerDamage,
+		HeroHealing:   sp.HeroHealing,
+		NetWorth:      sp.Networth,
+		KDA:           kda,
+		Lane:          sp.Lane,
+		Role:          sp.Role,
+		Items:         []int{sp.Item0ID, sp.Item1ID, sp.Item2ID, sp.Item3ID, sp.Item4ID, sp.Item5ID},
+		Backpack:      []int{sp.Backpack0ID, sp.Backpack1ID, sp.Backpack2ID},
+		NeutralItem:   sp.Neutral0ID,
+		ItemPurchases: purchases,
 	}
 }
 
 // AnalyzeStreakFromStratzMatches calcula la racha desde partidas Stratz para un jugador
 func AnalyzeStreakFromStratzMatches(matches []StratzMatch, steamAccountID int64) StreakResult {
 	if len(matches) == 0 {
-		return StreakResult{CurrentStreak: "Sin partidas"}
+		return StreakResult{}
 	}
 	wins := 0
 	losses := 0
@@ -796,18 +891,11 @@ func AnalyzeStreakFromStratzMatches(matches []StratzMatch, steamAccountID int64)
 			}
 		}
 	}
-	var currentStreak string
-	if isWinStreak {
-		currentStreak = fmt.Sprintf("%d victorias consecutivas 🔥", streakCount)
-	} else {
-		currentStreak = fmt.Sprintf("%d derrotas consecutivas 💀", streakCount)
-	}
 	return StreakResult{
-		Wins:          wins,
-		Losses:        losses,
-		CurrentStreak: currentStreak,
-		StreakCount:   streakCount,
-		IsWinStreak:   isWinStreak,
+		Wins:        wins,
+		Losses:      losses,
+		StreakCount: streakCount,
+		IsWinStreak: isWinStreak,
 	}
 }
 
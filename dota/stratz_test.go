@@ -0,0 +1,32 @@
+package dota
+
+import (
+	"encoding/json"
+	"testing"
+)
+
+func TestStratzEnumDecoding(t *testing.T) {
+	tests := []struct {
+		payload   string
+		lobbyType int
+		gameMode  int
+	}{
+		{`{"lobbyType": "RANKED", "gameMode": "ALL_PICK_RANKED"}`, LobbyTypeRanked, 22},
+		{`{"lobbyType": "UNRANKED", "gameMode": "ALL_PICK"}`, LobbyTypeNormal, GameModeAllPick},
+		{`{"lobbyType": "UNRANKED", "gameMode": "TURBO"}`, LobbyTypeNormal, GameModeTurbo},
+		{`{"lobbyType": "BATTLE_CUP", "gameMode": "CAPTAINS_MODE"}`, 9, 2},
+		{`{"lobbyType": 7, "gameMode": 22}`, LobbyTypeRanked, 22},
+		{`{"lobbyType": "7", "gameMode": "23"}`, LobbyTypeRanked, GameModeTurbo},
+		{`{"lobbyType": "NUEVO_ENUM", "gameMode": "NUEVO_ENUM"}`, 0, 0},
+	}
+	for _, tt := range tests {
+		var m StratzMatch
+		if err := json.Unmarshal([]byte(tt.payload), &m); err != nil {
+			t.Errorf("%s: %v", tt.payload, err)
+			continue
+		}
+		if int(m.LobbyType) != tt.lobbyType || int(m.GameMode) != tt.gameMode {
+			t.Errorf("%s: lobbyType=%d gameMode=%d, se esperaba %d/%d", tt.payload, m.LobbyType, m.GameMode, tt.lobbyType, tt.gameMode)
+		}
+	}
+}
@@ -0,0 +1,239 @@
+package dota
+
+import (
+	"sort"
+	"strings"
+	"time"
+)
+
+// PlayerMatchSummary agrega las estadísticas de un jugador sobre un conjunto de partidas Stratz
+type PlayerMatchSummary struct {
+	SteamAccountID int64
+	Games          int
+	Wins           int
+	Kills          int
+	Deaths         int
+	Assists        int
+	GoldPerMinute  int // suma; usar AvgGPM
+	XpPerMinute    int // suma; usar AvgXPM
+	HeroDamage     int // suma; usar AvgHeroDamage
+	LaneGames      int // partidas jugadas en una línea con resultado (sin jungle/roaming)
+	LaneWins       int
+	HeroGames      map[int]int // heroID -> partidas
+	HeroWins       map[int]int // heroID -> victorias
+}
+
+// HeroCount es un héroe con su número de partidas (para "héroes favoritos")
+type HeroCount struct {
+	HeroID int
+	Games  int
+	Wins   int
+}
+
+// Losses devuelve las derrotas del resumen
+func (s PlayerMatchSummary) Losses() int { return s.Games - s.Wins }
+
+// WinRate devuelve el % de victorias (0-100)
+func (s PlayerMatchSummary) WinRate() float64 { return percent(s.Wins, s.Games) }
+
+// LaneWinRate devuelve el % de victorias en fase de línea (0-100)
+func (s PlayerMatchSummary) LaneWinRate() float64 { return percent(s.LaneWins, s.LaneGames) }
+
+// KDA devuelve (K+A)/D sobre el total de partidas (K+A si no murió nunca)
+func (s PlayerMatchSummary) KDA() float64 {
+	if s.Deaths == 0 {
+		return float64(s.Kills + s.Assists)
+	}
+	return float64(s.Kills+s.Assists) / float64(s.Deaths)
+}
+
+// AvgKills, AvgDeaths y AvgAssists devuelven los promedios por partida
+func (s PlayerMatchSummary) AvgKills() float64   { return average(s.Kills, s.Games) }
+func (s PlayerMatchSummary) AvgDeaths() float64  { return average(s.Deaths, s.Games) }
+func (s PlayerMatchSummary) AvgAssists() float64 { return average(s.Assists, s.Games) }
+
+// AvgGPM, AvgXPM y AvgHeroDamage devuelven los promedios por partida
+func (s PlayerMatchSummary) AvgGPM() float64        { return average(s.GoldPerMinute, s.Games) }
+func (s PlayerMatchSummary) AvgXPM() float64        { return average(s.XpPerMinute, s.Games) }
+func (s PlayerMatchSummary) AvgHeroDamage() float64 { return average(s.HeroDamage, s.Games) }
+
+// TopHeroes devuelve los n héroes más jugados (desempate por victorias)
+func (s PlayerMatchSummary) TopHeroes(n int) []HeroCount {
+	out := make([]HeroCount, 0, len(s.HeroGames))
+	for heroID, games := range s.HeroGames {
+		out = append(out, HeroCount{HeroID: heroID, Games: games, Wins: s.HeroWins[heroID]})
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Games != out[j].Games {
+			return out[i].Games > out[j].Games
+		}
+		if out[i].Wins != out[j].Wins {
+			return out[i].Wins > out[j].Wins
+		}
+		return out[i].HeroID < out[j].HeroID
+	})
+	if n > 0 && len(out) > n {
+		out = out[:n]
+	}
+	return out
+}
+
+// SummarizePlayerMatches agrega las partidas en las que aparece steamAccountID
+func SummarizePlayerMatches(matches []StratzMatch, steamAccountID int64) PlayerMatchSummary {
+	summary := PlayerMatchSummary{
+		SteamAccountID: steamAccountID,
+		HeroGames:      make(map[int]int),
+		HeroWins:       make(map[int]int),
+	}
+	for i := range matches {
+		m := &matches[i]
+		p := FindStratzPlayer(m, steamAccountID)
+		if p == nil {
+			continue
+		}
+		won := IsStratzPlayerWin(m, p)
+		summary.Games++
+		if won {
+			summary.Wins++
+			summary.HeroWins[p.HeroID]++
+		}
+		summary.HeroGames[p.HeroID]++
+		summary.Kills += p.Kills
+		summary.Deaths += p.Deaths
+		summary.Assists += p.Assists
+		summary.GoldPerMinute += p.GoldPerMinute
+		summary.XpPerMinute += p.ExperiencePerMinute
+		summary.HeroDamage += p.HeroDamage
+		switch LaneResultForPlayer(m, p) {
+		case 1:
+			summary.LaneGames++
+			summary.LaneWins++
+		case -1:
+			summary.LaneGames++
+		}
+	}
+	return summary
+}
+
+// FilterMatchesSince devuelve las partidas que empezaron en since o después (conserva el orden)
+func FilterMatchesSince(matches []StratzMatch, since time.Time) []StratzMatch {
+	var out []StratzMatch
+	for _, m := range matches {
+		if m.StartDateTime >= since.Unix() {
+			out = append(out, m)
+		}
+	}
+	return out
+}
+
+// FindStratzPlayer devuelve el jugador con steamAccountID dentro de la partida, o nil
+func FindStratzPlayer(m *StratzMatch, steamAccountID int64) *StratzPlayer {
+	for i := range m.Players {
+		if m.Players[i].SteamAccountID == steamAccountID {
+			return &m.Players[i]
+		}
+	}
+	return nil
+}
+
+// IsStratzPlayerWin indica si el equipo del jugador ganó la partida
+func IsStratzPlayerWin(m *StratzMatch, p *StratzPlayer) bool {
+	return (m.DidRadiantWin && p.IsRadiant) || (!m.DidRadiantWin && !p.IsRadiant)
+}
+
+// LaneResultForPlayer devuelve 1 si el jugador ganó su línea, -1 si la perdió y 0 si empató,
+// no jugó una línea (jungle/roaming) o Stratz no devolvió lane outcomes.
+func LaneResultForPlayer(m *StratzMatch, p *StratzPlayer) int {
+	outcome := playerLaneOutcome(p.Lane, p.IsRadiant, m.TopLaneOutcome, m.MidLaneOutcome, m.BottomLaneOutcome)
+	switch strings.ToUpper(outcome) {
+	case "RADIANT_VICTORY", "RADIANT_STOMP":
+		if p.IsRadiant {
+			return 1
+		}
+		return -1
+	case "DIRE_VICTORY", "DIRE_STOMP":
+		if p.IsRadiant {
+			return -1
+		}
+		return 1
+	default:
+		return 0
+	}
+}
+
+// playerLaneOutcome devuelve el lane outcome de Stratz de la línea que jugó el jugador ("" si jungle/roaming)
+func playerLaneOutcome(lane string, isRadiant bool, top, mid, bottom string) string {
+	switch strings.ToUpper(lane) {
+	case "MID_LANE":
+		return mid
+	case "SAFE_LANE":
+		if isRadiant {
+			return bottom
+		}
+		return top
+	case "OFF_LANE":
+		if isRadiant {
+			return top
+		}
+		return bottom
+	default:
+		return ""
+	}
+}
+
+// HeadToHeadResult resume las partidas compartidas por dos jugadores
+type HeadToHeadResult struct {
+	TogetherGames int // mismo equipo
+	TogetherWins  int
+	AgainstGames  int // equipos contrarios
+	AgainstWinsA  int // victorias de A cuando jugaron en contra
+}
+
+// HeadToHead cruza las partidas de A y B por match ID y cuenta el récord jugando juntos y en contra.
+// Basta con pasar las partidas de ambos; las partidas repetidas se cuentan una vez.
+func HeadToHead(matchesA, matchesB []StratzMatch, accountA, accountB int64) HeadToHeadResult {
+	var result HeadToHeadResult
+	seen := make(map[int64]bool)
+	all := make([]StratzMatch, 0, len(matchesA)+len(matchesB))
+	all = append(all, matchesA...)
+	all = append(all, matchesB...)
+	for i := range all {
+		m := &all[i]
+		if seen[m.ID] {
+			continue
+		}
+		pa := FindStratzPlayer(m, accountA)
+		pb := FindStratzPlayer(m, accountB)
+		if pa == nil || pb == nil {
+			continue
+		}
+		seen[m.ID] = true
+		winA := IsStratzPlayerWin(m, pa)
+		if pa.IsRadiant == pb.IsRadiant {
+			result.TogetherGames++
+			if winA {
+				result.TogetherWins++
+			}
+		} else {
+			result.AgainstGames++
+			if winA {
+				result.AgainstWinsA++
+			}
+		}
+	}
+	return result
+}
+
+func percent(part, total int) float64 {
+	if total == 0 {
+		return 0
+	}
+	return 100 * float64(part) / float64(total)
+}
+
+func average(sum, n int) float64 {
+	if n == 0 {
+		return 0
+	}
+	return float64(sum) / float64(n)
+}
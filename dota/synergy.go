@@ -0,0 +1,176 @@
+package dota
+
+import (
+	"sort"
+	"strconv"
+	"strings"
+)
+
+// PartyRecord es el récord de un grupo de jugadores registrados jugando en el mismo equipo
+type PartyRecord struct {
+	AccountIDs []int64 // ordenados de menor a mayor
+	Games      int
+	Wins       int
+}
+
+// WinRate devuelve el % de victorias del grupo (0-100)
+func (r PartyRecord) WinRate() float64 { return percent(r.Wins, r.Games) }
+
+// Losses devuelve las derrotas del grupo
+func (r PartyRecord) Losses() int { return r.Games - r.Wins }
+
+// Includes indica si steamAccountID forma parte del grupo
+func (r PartyRecord) Includes(steamAccountID int64) bool {
+	for _, id := range r.AccountIDs {
+		if id == steamAccountID {
+			return true
+		}
+	}
+	return false
+}
+
+// SynergyReport agrupa el récord por dúo y por stack completo (3+ registrados en el mismo equipo)
+type SynergyReport struct {
+	Matches int           // partidas con al menos 2 registrados en el mismo equipo
+	Pairs   []PartyRecord // ordenados por partidas (desc)
+	Stacks  []PartyRecord // ordenados por partidas (desc)
+}
+
+// ComputeSynergy busca en los historiales de los jugadores registrados (steamAccountID -> partidas) todas las partidas
+// en las que dos o más de ellos jugaron en el mismo equipo. Cada partida se cuenta una sola vez aunque aparezca
+// en varios historiales.
+func ComputeSynergy(histories map[int64][]StratzMatch) SynergyReport {
+	registered := make(map[int64]bool, len(histories))
+	for id := range histories {
+		registered[id] = true
+	}
+
+	pairs := make(map[string]*PartyRecord)
+	stacks := make(map[string]*PartyRecord)
+	seen := make(map[int64]bool)
+	var report SynergyReport
+
+	for _, matches := range histories {
+		for i := range matches {
+			m := &matches[i]
+			if seen[m.ID] {
+				continue
+			}
+			seen[m.ID] = true
+
+			var radiant, dire []int64
+			for _, p := range m.Players {
+				if !registered[p.SteamAccountID] {
+					continue
+				}
+				if p.IsRadiant {
+					radiant = append(radiant, p.SteamAccountID)
+				} else {
+					dire = append(dire, p.SteamAccountID)
+				}
+			}
+			counted := false
+			for _, team := range []struct {
+				ids []int64
+				won bool
+			}{{radiant, m.DidRadiantWin}, {dire, !m.DidRadiantWin}} {
+				if len(team.ids) < 2 {
+					continue
+				}
+				counted = true
+				sort.Slice(team.ids, func(a, b int) bool { return team.ids[a] < team.ids[b] })
+				for a := 0; a < len(team.ids); a++ {
+					for b := a + 1; b < len(team.ids); b++ {
+						addPartyResult(pairs, []int64{team.ids[a], team.ids[b]}, team.won)
+					}
+				}
+				if len(team.ids) >= 3 {
+					addPartyResult(stacks, team.ids, team.won)
+				}
+			}
+			if counted {
+				report.Matches++
+			}
+		}
+	}
+
+	report.Pairs = sortedPartyRecords(pairs)
+	report.Stacks = sortedPartyRecords(stacks)
+	return report
+}
+
+// BestPair devuelve el dúo con mayor % de victorias con al menos minGames partidas juntos
+func (r SynergyReport) BestPair(minGames int) (PartyRecord, bool) {
+	return pickPair(r.Pairs, minGames, func(a, b PartyRecord) bool { return a.WinRate() > b.WinRate() })
+}
+
+// WorstPair devuelve el dúo con menor % de victorias con al menos minGames partidas juntos
+func (r SynergyReport) WorstPair(minGames int) (PartyRecord, bool) {
+	return pickPair(r.Pairs, minGames, func(a, b PartyRecord) bool { return a.WinRate() < b.WinRate() })
+}
+
+// FilterByAccount devuelve un reporte solo con los dúos y stacks en los que participa steamAccountID.
+// Matches no se recalcula (queda en 0).
+func (r SynergyReport) FilterByAccount(steamAccountID int64) SynergyReport {
+	var out SynergyReport
+	for _, p := range r.Pairs {
+		if p.Includes(steamAccountID) {
+			out.Pairs = append(out.Pairs, p)
+		}
+	}
+	for _, s := range r.Stacks {
+		if s.Includes(steamAccountID) {
+			out.Stacks = append(out.Stacks, s)
+		}
+	}
+	return out
+}
+
+func addPartyResult(records map[string]*PartyRecord, ids []int64, won bool) {
+	parts := make([]string, len(ids))
+	for i, id := range ids {
+		parts[i] = strconv.FormatInt(id, 10)
+	}
+	key := strings.Join(parts, ",")
+	rec, ok := records[key]
+	if !ok {
+		rec = &PartyRecord{AccountIDs: append([]int64(nil), ids...)}
+		records[key] = rec
+	}
+	rec.Games++
+	if won {
+		rec.Wins++
+	}
+}
+
+func sortedPartyRecords(records map[string]*PartyRecord) []PartyRecord {
+	out := make([]PartyRecord, 0, len(records))
+	for _, rec := range records {
+		out = append(out, *rec)
+	}
+	sort.Slice(out, func(i, j int) bool {
+		if out[i].Games != out[j].Games {
+			return out[i].Games > out[j].Games
+		}
+		if out[i].WinRate() != out[j].WinRate() {
+			return out[i].WinRate() > out[j].WinRate()
+		}
+		return out[i].AccountIDs[0] < out[j].AccountIDs[0]
+	})
+	return out
+}
+
+func pickPair(pairs []PartyRecord, minGames int, better func(a, b PartyRecord) bool) (PartyRecord, bool) {
+	var best PartyRecord
+	found := false
+	for _, p := range pairs {
+		if p.Games < minGames {
+			continue
+		}
+		if !found || better(p, best) {
+			best = p
+			found = true
+		}
+	}
+	return best, found
+}
@@ -0,0 +1,477 @@
+package i18n
+
+// bundleEN es el catálogo en inglés; también trae los nombres y descripciones de comandos (claves cmd.*).
+var bundleEN = map[string]string{
+	"lang.name": "English",
+
+	"err.invalid_command":       "❌ Invalid command. Use `/dota help` to see the available commands.",
+	"err.unknown_command":       "❌ Unknown command. Use `/dota help` to see the available commands.",
+	"err.stratz_not_configured": "❌ Stratz is not configured.",
+	"err.user_unknown":          "❌ Could not identify the user",
+	"err.invalid_account_id":    "❌ Invalid account_id",
+	"err.no_users":              "❌ No registered users. Use `/dota register account_id:<your_steam_id>` to register players.",
+	"err.matches":               "❌ Error fetching matches: %v",
+	"err.need_manage":           "❌ This command requires the Manage Server permission.",
+	"history.page_error":        "❌ Could not update the history, try again.",
+
+	"player.default": "Player",
+
+	"search.usage":         "❌ Usage: `/dota search name:<name>`",
+	"search.not_supported": "🔍 Searching by name is not available with Stratz.\n\nUse your **Steam ID** (account_id) directly:\n`/dota register account_id:<your_steam_id>`\n\nYou can find your Steam ID at https://stratz.com (look up your profile or matches).",
+	"search.error":         "❌ Search error: %v",
+	"search.no_results":    "❌ No players found with that name",
+	"search.title":         "🔍 **Search results:**\n\n",
+	"search.no_name":       "No name",
+	"search.last_match":    "   Last match: %s\n",
+	"search.footer":        "Use `/dota register account_id:<number>` to register a player\nOr `/dota register account_id:<number> user:@friend` to register another user",
+
+	"register.usage":        "❌ Usage: `/dota register account_id:<account_id>` or `/dota register account_id:<number>` after a search",
+	"register.no_search":    "❌ No search results available. Use `/dota search name:<name>` first.",
+	"register.not_number":   "❌ The account_id must be a number",
+	"register.verify_error": "❌ Error verifying player (Stratz): %v",
+	"register.not_found":    "❌ Player not found on Stratz",
+	"register.save_error":   "❌ Error saving registration",
+	"register.ok":           "✅ **%s** (Discord) linked to **%s** (Dota 2)\nDota ID: %s",
+
+	"channel.invalid":    "❌ Invalid channel",
+	"channel.save_error": "❌ Error saving channel",
+	"channel.ok":         "✅ Notification channel set: <#%s>",
+
+	"stats.hero_not_found":  "❌ Hero not found: %q",
+	"stats.error":           "❌ Error fetching stats: %v",
+	"stats.no_heroes":       "**%s** has no heroes with at least %d games in the last %d analyzed matches.",
+	"stats.no_heroes_all":   "No registered player has heroes with at least %d games in the last %d analyzed matches.",
+	"stats.filters":         " (filters: %s)",
+	"stats.title":           "📊 Hero stats — %s",
+	"stats.footer":          "%d matches analyzed • ≥%d games per hero • Stratz",
+	"stats.footer_filtered": "%d matches analyzed • ≥%d games per hero • %s • Stratz",
+
+	"hero.footer":          "Last %d matches with %s • Stratz",
+	"hero.footer_filtered": "Last %d matches with %s • %s • Stratz",
+	"hero.no_games":        "No games with %s in the last %d matches.",
+	"hero.games":           "Games",
+	"hero.last_played":     "Last played",
+	"hero.avg_kda":         "Average K/D/A",
+	"hero.info":            "Hero",
+	"hero.complexity":      "Complexity %s",
+	"hero.aliases":         "Nicknames: %s",
+
+	"attr.str":     "Strength",
+	"attr.agi":     "Agility",
+	"attr.int":     "Intelligence",
+	"attr.all":     "Universal",
+	"attr.unknown": "Unknown",
+
+	"help.title":       "🎮 Dota 2 Bot Commands",
+	"help.description": "Available commands. Use register to link a Discord ID with a Dota ID.",
+	"help.search":      "Search players by Steam name. Returns up to 10 numbered results.\n**Example:** `/dota search name:Desp4irs`",
+	"help.register":    "Links a Dota ID to a Discord user and saves it in `data/users.json`.\n- If you omit `user`, you register yourself.\n- Use a number after a search (1-10) or a direct ID.\n**Examples:** `/dota register account_id:136201811` · `/dota register account_id:1` · `/dota register account_id:136201811 user:@friend`",
+	"help.channel":     "Sets the channel for automatic new-match notifications.\n**Example:** `/dota channel channel:#dota-updates`",
+	"help.stats":       "Per-hero stats (W/L, %) with ≥STATS_MIN_GAMES games in the last STATS_TAKE matches; yours by default. Colors: 🔴 ≤40%, 🟡 40-50%, 🟢 ≥50%.\nWith `hero` (name or nickname, with autocomplete): games, W/L, average K/D/A and GPM, last played and the hero's attribute/roles/complexity. With `all:true`: one message per registered player.\nOptional filters: `role` (core/support), `lane` (safe/mid/off), `mode` (ranked/turbo/all pick), `from`/`to` (YYYY-MM-DD) or `patch:true`.",
+	"help.match":       "Report for any match. Without `player` it shows both teams' scoreboard (K/D/A, net worth, lanes); with `player` it shows the same card as the automatic notification.\n**Example:** `/dota match id:8123456789`",
+	"help.last":        "A registered user's latest match right now, without waiting for the poller (useful when the notification waits for the parse). You by default.",
+	"help.history":     "Last N matches (up to 100): hero, result, K/D/A, duration, mode and Stratz link. Buttons to paginate and a menu to open any full match.",
+	"help.profile":     "Profile card: medal, all-time W/L, current streak, top 5 heroes and form over the last 20 matches (🟩/🟥).",
+	"help.compare":     "Head to head over the last STATS_TAKE matches: win %, K/D/A, GPM/XPM, damage, laning, favorite heroes and record together / against.",
+	"help.leaderboard": "Ranking of all registered players in a single message by win %, KDA, GPM, games, streak or rank. Win %/KDA/GPM require ≥STATS_MIN_GAMES games in the period. 🥇🥈🥉 for the podium.",
+	"help.synergy":     "Do we win more when we play together? Win % per duo and per stack (3+) of registered players on the same team, with the best and worst duo.",
+	"help.rank":        "Rank history: every medal/star change recorded with its date. Medal promotions and demotions are announced in the notification channel.",
+	"help.help":        "Show this help",
+	"help.language":    "Language of the bot's replies: `es` or `en`. By default your Discord client's language is used; `auto` clears your preference. Priority: your preference → your client's language → the server's language (if your client is neither in Spanish nor English). With `server:true` (requires Manage Server) it changes the server's default language, used in notifications.",
+	"help.template":    "Server notification template (replies only visible to you). `show`: current template, preview and its JSON. `preset`: compact, full or meme. `edit json:<...>`: your own template (`text/template` over the match: `{{.Player.Hero}}`, `{{.Match.Duration}}`, `{{.Streak.Text}}`…). A preview is always shown before saving. `reset`: default format. Requires Manage Server except `show`.",
+	"help.route":       "Rules to send notifications to other channels (replies only visible to you). `add channel:<#channel>` with one or more conditions: `mode` (ranked/normal/turbo/all pick), `mode_id`, `lobby_id`, `result`, `user` and `highlight`. The first matching rule wins; with no match the notification channel is used. `remove number:<n>`, `list`. Requires Manage Server except `list`.",
+	"help.quiet":       "Server quiet hours (replies only visible to you). `set from:<HH:MM> to:<HH:MM> timezone:<IANA zone>`: matches in that window are not notified one by one but in a single digest when it ends (games, W/L and best game per player). `off`, `show`. Requires Manage Server except `show`.",
+	"help.config":      "Bot settings without a restart (replies only visible to you). `get [key]` shows the current value; `set key:<variable> value:<value>` changes REFRESH_RATE (1–60 minutes), PARSED (true/false), STATS_MIN_GAMES (≥2), STATS_TAKE (0–100, 0 = 100) STATS_TIME or RECAP_TIME (HH:MM or off). Applied immediately and saved. Requires Manage Server.",
+	"help.notify":      "Your notification preferences (replies only visible to you): `enabled` (on/off), `result` (all/wins/losses), `ranked_only`, `highlights_only` (highlights only), `mention` (mention you) and `dm` (DM copy; requires allowing DMs from server members). Without options it shows the current ones.",
+
+	"welcome.title":       "🤖 Dota 2 Bot - Online!",
+	"welcome.description": "The bot is running and watching for matches. Here are the available commands:",
+	"welcome.help":        "Shows the full list of commands.",
+	"welcome.search":      "Searches Dota 2 players by Steam name. Returns up to 10 numbered results.\n**Example:** `/dota search name:Desp4irs`",
+	"welcome.register":    "Links a Discord user with a Dota 2 ID and saves it in `data/users.json`.\n- If you omit `user`, whoever runs the command is registered.\n- You can register a friend with `user:@friend`.\n**Examples:** `/dota register account_id:136201811` · `/dota register account_id:1` · `/dota register account_id:136201811 user:@friend`",
+	"welcome.channel":     "Sets the channel for automatic new-match notifications.\n**Example:** `/dota channel channel:#dota-updates`",
+	"welcome.stats":       "Per-hero stats in the current patch: W/L and win % (heroes with ≥10 games).",
+	"welcome.footer":      "The bot checks for new matches every 10 minutes automatically",
+
+	"language.invalid":      "❌ Invalid language: %q (use es, en or auto)",
+	"language.need_manage":  "❌ Changing the server language requires the Manage Server permission.",
+	"language.guild_only":   "❌ The server language can only be changed from a server.",
+	"language.guild_auto":   "❌ The server language must be `es` or `en`.",
+	"language.save_error":   "❌ Error saving language",
+	"language.set_user":     "✅ Language for your replies: **%s**",
+	"language.cleared_user": "✅ Preference cleared: your Discord client's language will be used (currently **%s**).",
+	"language.set_guild":    "✅ Server default language: **%s**",
+
+	"route.guild_only":         "❌ Routing rules are per server: use the command from a server.",
+	"route.no_condition":       "❌ Give at least one condition (modo, modo_id, lobby_id, resultado, usuario or destacada).",
+	"route.too_many":           "❌ At most %d rules per server. Remove one with `/dota route remove`.",
+	"route.save_error":         "❌ Error saving routing rules",
+	"route.added":              "✅ Rule **%d** added: %s",
+	"route.removed":            "✅ Rule **%d** removed: %s",
+	"route.not_found":          "❌ Rule %d does not exist. Check the numbers with `/dota route list`.",
+	"route.empty":              "No routing rules: every notification goes to %s.",
+	"route.list_title":         "🔀 **Routing rules** (the first match wins):",
+	"route.fallback":           "No match → %s",
+	"route.cond_mode":          "Mode %s",
+	"route.cond_lobby":         "Lobby %s",
+	"route.cond_win":           "Win",
+	"route.cond_loss":          "Loss",
+	"route.cond_player":        "<@%s>",
+	"route.cond_any_highlight": "Highlight",
+	"route.cond_highlight":     "Highlight `%s`",
+
+	"quiet.guild_only":    "❌ Quiet hours are per server: use the command from a server.",
+	"quiet.invalid_time":  "❌ Invalid time: %q (use HH:MM, e.g. 01:00)",
+	"quiet.empty_window":  "❌ Start and end cannot be the same time.",
+	"quiet.invalid_zone":  "❌ Unknown time zone: %q (use the IANA name, e.g. Europe/Madrid)",
+	"quiet.save_error":    "❌ Error saving quiet hours",
+	"quiet.saved":         "✅ Quiet hours: **%s–%s** (%s). Matches in that window will be posted as a digest when it ends.",
+	"quiet.off":           "✅ Quiet hours disabled. If matches are queued, the digest is posted on the next check.",
+	"quiet.none":          "No quiet hours. Queued matches: %d.",
+	"quiet.current":       "🌙 Quiet hours: **%s–%s** (%s) · %s · queued matches: %d",
+	"quiet.active":        "active now",
+	"quiet.inactive":      "inactive now",
+	"config.guild_only":   "❌ Settings are saved per server: use the command from a server.",
+	"config.not_home":     "❌ Settings are shared by the whole bot and can only be changed from the main server (SERVER_ID or the notification channel's server).",
+	"config.invalid":      "❌ Invalid value for %s: %v",
+	"config.save_error":   "❌ Error saving the setting (nothing was changed)",
+	"config.saved":        "✅ `%s` = **%s**. Applied without a restart.",
+	"config.current":      "⚙️ Current settings:",
+	"config.source_env":   ".env",
+	"config.source_saved": "/dota config",
+	"digest.title":        "🌙 Quiet hours digest",
+	"digest.description":  "%d match(es) from %d player(s) while the channel was quiet",
+	"digest.player":       "<@%s> · %d match(es) · %dW - %dL · ⏱️ %s",
+	"digest.best":         "⭐ Best game",
+
+	"session.title":       "🎮 Session over",
+	"session.title_party": "🎮 Party session over",
+	"session.description": "%d match(es) · ⏱️ %s · <t:%d:t>–<t:%d:t>",
+	"session.player":      "<@%s> · %d match(es) · %dW - %dL · ⏱️ %s",
+	"session.rank":        "📊 Rank: %s",
+	"session.rank_same":   "%s (no change)",
+	"session.heroes":      "🦸 %s",
+	"session.mvp":         "⭐ MVP of the night",
+
+	"notify.not_registered":  "❌ You are not registered. Use `/dota register` first.",
+	"notify.invalid_result":  "❌ Invalid result: %q (use all, win or loss)",
+	"notify.save_error":      "❌ Error saving notification preferences",
+	"notify.current":         "🔔 Your match notifications:",
+	"notify.saved":           "✅ Preferences saved:",
+	"notify.enabled":         "Enabled",
+	"notify.result":          "Result",
+	"notify.result_all":      "all",
+	"notify.result_win":      "wins only",
+	"notify.result_loss":     "losses only",
+	"notify.ranked_only":     "Ranked only",
+	"notify.highlights_only": "Highlights only",
+	"notify.mention":         "Mention you",
+	"notify.dm":              "DM copy",
+	"notify.yes":             "yes",
+	"notify.no":              "no",
+
+	"match.unparsed":     "⏳ Not parsed on Stratz yet",
+	"match.win":          "✅ Victory",
+	"match.loss":         "❌ Defeat",
+	"match.duration":     "Duration",
+	"match.level":        "Level",
+	"match.mode":         "Mode",
+	"match.hero_record":  "Record with %s (last 20)",
+	"match.lane_role":    "Lane / Role",
+	"match.lane_results": "Lane results",
+	"match.players":      "👥 Players (Public Profiles)",
+	"match.and_more":     "... and more",
+	"match.streak_win":   "%d wins in a row 🔥",
+	"match.streak_loss":  "%d losses in a row 💀",
+	"match.mvp":          "🏅 MVP",
+	"match.worst":        "💩 Worst performance",
+
+	"lane.radiant_victory": "Radiant victory",
+	"lane.radiant_stomp":   "Radiant stomp",
+	"lane.dire_victory":    "Dire victory",
+	"lane.dire_stomp":      "Dire stomp",
+	"lane.tie":             "Tie",
+	"lane.you":             "%s (you)",
+	"lane.phase_tie":       "*Tied laning phase*",
+	"lane.phase_win":       "*✅ Won laning phase*",
+	"lane.phase_loss":      "*❌ Lost laning phase*",
+
+	"template.guild_only":      "❌ Templates are per server: use the command from a server.",
+	"template.current_default": "Current template: **default**. Pick one with `/dota template preset` (%s) or write your own with `/dota template edit`.",
+	"template.current_preset":  "Current template: preset **%s**. The attached JSON is a starting point for `/dota template edit`.",
+	"template.current_custom":  "Current template: **custom**. To change it, edit the attached JSON and paste it into `/dota template edit`.",
+	"template.unknown_preset":  "❌ Unknown preset: %q (use %s)",
+	"template.invalid_json":    "❌ Invalid JSON: %v",
+	"template.invalid":         "❌ The template is not valid: %v",
+	"template.preview":         "👀 Preview with a sample match. Save it as the server's notification template?",
+	"template.save":            "Save",
+	"template.cancel":          "Cancel",
+	"template.saved":           "✅ Template saved: upcoming notifications will use this format.",
+	"template.cancelled":       "Changes discarded.",
+	"template.expired":         "❌ This preview is no longer available. Run the command again.",
+	"template.save_error":      "❌ Error saving template",
+	"template.reset":           "✅ Template reset: notifications use the default format.",
+	"template.sample_player":   "Sample player",
+	"tpl.meme.win_title":       "🐐 %s carried the whole team",
+	"tpl.meme.loss_title":      "🤡 %s fed the enemy team",
+	"tpl.meme.feeder":          "☠️ %d deaths. That's not a KDA, that's a phone number.",
+	"tpl.meme.deathless":       "😇 Zero deaths. The enemy didn't even know they existed.",
+	"tpl.meme.win":             "GG EZ on %s. Didn't even break a sweat.",
+	"tpl.meme.loss":            "Team's fault, obviously. (%s)",
+	"tpl.meme.kda":             "**%d/%d/%d** in %s",
+
+	// Comandos: cmd.<subcomando>.desc, cmd.<subcomando>.<opción>.desc, opt.<opción>.name/desc (compartidas)
+
+	"common.no_matches":       "No matches",
+	"compare.usage":           "❌ Usage: `/dota compare a:@user b:@user`",
+	"compare.same_user":       "❌ Pick two different users",
+	"err.not_registered":      "❌ **%s** is not registered. Use `/dota register account_id:<id> user:@%s`",
+	"err.invalid_account_for": "❌ Invalid account_id for **%s**",
+	"compare.matches_error":   "❌ Error fetching matches for **%s**: %v",
+	"compare.winrate":         "Win %",
+	"compare.hero_damage":     "Hero damage",
+	"compare.lane":            "Laning phase",
+	"compare.metric_tie":      "%s: tie",
+	"compare.winner":          "🏆 **%s** wins %d-%d",
+	"compare.tie":             "🤝 Tie %d-%d",
+	"compare.no_common":       "No matches in common",
+	"compare.together":        "🤝 Together: %d-%d (%.1f%%)",
+	"compare.against":         "⚔️ Against: %s %d - %d %s",
+	"compare.h2h":             "Together / Against",
+	"compare.verdict":         "Verdict",
+	"compare.footer":          "Last %d matches of each player • Stratz",
+	"compare.col_games":       "**Matches:** %d (%d-%d, %.1f%%)",
+	"compare.col_damage":      "**Hero damage:** %s",
+	"compare.col_lane":        "**Laning phase:** %s",
+	"compare.col_favorites":   "**Favorites:** %s",
+
+	"err.no_recent":           "❌ **%s** has no recent matches on Stratz",
+	"err.match":               "❌ Error fetching match %d",
+	"err.match_detail":        "❌ Error fetching match %d: %v",
+	"err.match_not_found":     "❌ Match %d not found on Stratz",
+	"err.player_not_in_match": "❌ Player %s not found in match %d",
+	"match.usage":             "❌ Usage: `/dota match id:<match_id> [player:@user]`",
+	"match.not_played":        "❌ **%s** did not play match %d",
+	"match.title":             "Match %d",
+	"match.your_team":         "your team",
+	"scoreboard.anonymous":    "Anonymous",
+	"scoreboard.player_id":    "Player %d",
+	"scoreboard.player":       "Player",
+	"scoreboard.damage":       "Damage",
+	"graph.legend":            "📈 Advantage: 🟡 gold · 🔵 XP (top = %s)",
+	"history.win":             "Victory",
+	"history.loss":            "Defeat",
+	"history.title":           "📜 History — %s",
+	"history.footer":          "Page %d/%d • %d matches • Stratz",
+	"history.previous":        "◀ Previous",
+	"history.next":            "Next ▶",
+	"history.select":          "View full match…",
+
+	"leaderboard.usage":           "❌ Usage: `/dota leaderboard metric:<winrate|kda|gpm|partidas|racha|rango> period:<dia|semana|mes>`",
+	"leaderboard.period.dia":      "last 24 hours",
+	"leaderboard.period.semana":   "last 7 days",
+	"leaderboard.period.mes":      "last 30 days",
+	"leaderboard.metric.winrate":  "Win %",
+	"leaderboard.metric.kda":      "KDA",
+	"leaderboard.metric.gpm":      "Average GPM",
+	"leaderboard.metric.partidas": "Matches played",
+	"leaderboard.metric.racha":    "Current streak",
+	"leaderboard.metric.rango":    "Rank",
+	"leaderboard.no_data":         "No registered player has enough data (≥%d matches) in the %s.",
+	"leaderboard.players":         "%d players",
+	"leaderboard.below_min":       "%d with fewer than %d matches skipped",
+
+	"rank.uncalibrated":      "Uncalibrated",
+	"profile.error":          "❌ Error fetching profile (Stratz): %v",
+	"profile.lifetime":       "%d-%d (%.1f%%) • %d matches",
+	"profile.form":           "%s\n%d-%d (%.0f%%) • oldest → newest",
+	"profile.no_heroes":      "No heroes with ≥%d matches",
+	"profile.title":          "👤 Profile — %s",
+	"profile.rank":           "Rank",
+	"profile.lifetime_title": "Lifetime W/L",
+	"profile.streak":         "Current streak",
+	"profile.form_title":     "Form (last %d)",
+	"profile.top_heroes":     "Top 5 heroes",
+	"profile.footer":         "Heroes: last %d matches • ≥%d matches per hero • Stratz",
+
+	"rank.demoted":       "📉 Medal demotion",
+	"rank.promoted":      "🎉 Medal promotion!",
+	"rank.change_footer": "Use /dota rank to see the history • Stratz",
+	"rank.no_history":    "**%s** has no recorded rank yet (private profile or uncalibrated?).",
+	"rank.footer":        "%d changes recorded since %s",
+	"rank.stars":         "%+d stars",
+	"rank.title":         "📈 Rank history — %s",
+	"rank.current":       "Current: **%s**\n\n%s",
+
+	"recap.semanal.title":  "📅 Weekly recap",
+	"recap.semanal.label":  "last 7 days",
+	"recap.mensual.title":  "🗓️ Monthly recap",
+	"recap.mensual.label":  "last month",
+	"recap.description":    "%d matches from %d players in the %s",
+	"recap.player":         "%s — %d matches (%d-%d, %.1f%%)",
+	"recap.players":        "Matches per player",
+	"recap.best":           "🌟 Best match (KDA)",
+	"recap.worst":          "💩 Worst match (KDA)",
+	"recap.hero":           "**%s** — %d matches (%.1f%%)",
+	"recap.heroes":         "🦸 Most played heroes",
+	"recap.longest_streak": "Longest streak",
+	"recap.rank_change":    "Biggest rank change",
+	"recap.no_rank_change": "No rank changes",
+	"recap.match_link":     "match",
+	"recap.footer":         "Best/worst match by KDA • synergy with ≥%d matches together • Stratz",
+
+	"synergy.need_two":     "❌ At least 2 registered users are needed to compute synergies.",
+	"synergy.title_group":  "🤝 Group synergy",
+	"synergy.title_player": "🤝 %s's synergy",
+	"synergy.none":         "No matches with 2 or more registered players on the same team in the last %d matches.",
+	"synergy.footer":       "Last %d matches of each registered player • best/worst duo with ≥%d matches together • Stratz",
+	"synergy.description":  "%d matches with 2 or more registered players on the same team",
+	"synergy.best":         "🔥 Best duo",
+	"synergy.worst":        "💀 Worst duo",
+	"synergy.pairs":        "Duos",
+
+	"filter.role":         "Role: %s",
+	"filter.lane":         "Lane: %s",
+	"filter.mode":         "Mode: %s",
+	"filter.invalid_date": "❌ Invalid `%s` date (%q), use YYYY-MM-DD",
+	"filter.from":         "From %s",
+	"filter.to":           "To %s",
+	"filter.patch":        "Current patch (%d days)",
+	"filter.bad_range":    "❌ `from` must be before `to`",
+
+	"match.items": "Items",
+
+	"highlight.kills":          "🔪 %d kills",
+	"highlight.deathless":      "🛡️ Deathless",
+	"highlight.gpm":            "💰 %d GPM",
+	"highlight.comeback":       "🔄 Comeback: lane lost by a stomp and match won",
+	"highlight.longest_game":   "⏳ Longest match of the month (%s)",
+	"highlight.win_streak":     "🔥 %d wins in a row",
+	"highlight.loss_streak":    "💀 %d losses in a row",
+	"highlight.hero_damage_pb": "📈 Hero damage record: %d (last %d matches)",
+	"highlight.healing_pb":     "💚 Healing record: %d (last %d matches)",
+	"scoring.weights":          "KDA %.0f, damage %.0f, towers %.0f, healing %.0f, GPM %.0f",
+	"scoring.description":      "MVP: %% of the match maximum per metric • Core: %s • Support: %s",
+
+	"cmd.dota.desc":                   "Dota 2 bot commands",
+	"cmd.search.desc":                 "Search players by Steam name",
+	"cmd.search.nombre.desc":          "Name of the player to search",
+	"cmd.register.desc":               "Register your Dota 2 account",
+	"cmd.register.account_id.desc":    "Dota ID or search result number",
+	"cmd.register.usuario.desc":       "Discord user to register (optional, defaults to you)",
+	"cmd.channel.desc":                "Set the notification channel",
+	"cmd.channel.canal.desc":          "Channel for notifications",
+	"cmd.stats.desc":                  "Per-hero stats (W/L, win %); yours by default",
+	"cmd.stats.heroe.desc":            "Hero detail (name or nickname, e.g. Anti-Mage, am)",
+	"cmd.stats.todos.desc":            "One message per registered user",
+	"cmd.match.desc":                  "Report for any match by ID",
+	"cmd.match.id.desc":               "Match ID",
+	"cmd.match.jugador.desc":          "Registered player whose perspective to show (optional)",
+	"cmd.last.desc":                   "A registered player's latest match (even if not parsed yet)",
+	"cmd.last.privado.desc":           "Reply only to you (public by default)",
+	"cmd.history.desc":                "A registered player's latest matches, paginated",
+	"cmd.history.cantidad.desc":       "Matches to load (1-100, default 20)",
+	"cmd.profile.desc":                "Profile: rank, all-time W/L, streak, top heroes and recent form",
+	"cmd.compare.desc":                "Compare two registered players side by side",
+	"cmd.compare.a.desc":              "First player",
+	"cmd.compare.b.desc":              "Second player",
+	"cmd.leaderboard.desc":            "Ranking of all registered players",
+	"cmd.leaderboard.metrica.desc":    "Metric to sort by",
+	"cmd.leaderboard.periodo.desc":    "Period (week by default)",
+	"cmd.synergy.desc":                "Win % of duos and stacks of registered players playing together",
+	"cmd.synergy.usuario.desc":        "Only this user's duos/stacks (optional)",
+	"cmd.rank.desc":                   "A user's rank history (medal and stars)",
+	"cmd.help.desc":                   "Show help",
+	"cmd.template.desc":               "Server match notification template",
+	"cmd.template.show.desc":          "Show the current template, its preview and its JSON",
+	"cmd.template.preset.desc":        "Pick a built-in template (previewed before saving)",
+	"cmd.template.preset.nombre.desc": "Built-in template",
+	"cmd.template.edit.desc":          "Custom template in JSON (previewed before saving)",
+	"cmd.template.edit.json.desc":     "Template JSON (copy the one from /dota template show and edit it)",
+	"cmd.template.reset.desc":         "Go back to the default notification format",
+	"choice.nombre.compact":           "Compact",
+	"choice.nombre.full":              "Full",
+	"cmd.route.desc":                  "Rules to send notifications to other channels depending on the match",
+	"cmd.route.add.desc":              "Add a rule: matches meeting every condition go to the channel",
+	"cmd.route.add.canal.desc":        "Target channel",
+	"cmd.route.add.modo.desc":         "Game mode",
+	"cmd.route.add.modo_id.desc":      "game_mode ID (other modes)",
+	"cmd.route.add.lobby_id.desc":     "lobby_type ID (other lobby types)",
+	"cmd.route.add.resultado.desc":    "Result for the player",
+	"cmd.route.add.usuario.desc":      "Only this user's matches",
+	"cmd.route.add.destacada.desc":    "Highlight matches only (any or a specific rule)",
+	"cmd.route.remove.desc":           "Remove a rule",
+	"cmd.route.remove.numero.desc":    "Rule number in /dota route list",
+	"cmd.route.list.desc":             "Show the rules in evaluation order",
+	"choice.destacada.any":            "Any",
+	"cmd.quiet.desc":                  "Quiet hours: matches are grouped into a digest when the window ends",
+	"cmd.quiet.set.desc":              "Set the quiet hours window",
+	"cmd.quiet.set.desde.desc":        "Start (HH:MM, e.g. 01:00)",
+	"cmd.quiet.set.hasta.desc":        "End (HH:MM, e.g. 09:00)",
+	"cmd.quiet.set.zona.desc":         "IANA time zone (e.g. Europe/Madrid, America/Mexico_City)",
+	"cmd.quiet.off.desc":              "Disable quiet hours",
+	"cmd.quiet.show.desc":             "Show the current window and queued matches",
+	"cmd.config.desc":                 "Bot settings: view or change .env variables without a restart",
+	"cmd.config.get.desc":             "Show the current value of the variables",
+	"cmd.config.get.clave.desc":       "Variable (all of them if omitted)",
+	"cmd.config.set.desc":             "Change a variable (applied immediately and saved)",
+	"cmd.config.set.clave.desc":       "Variable",
+	"cmd.config.set.valor.desc":       "New value (e.g. 5, true, 20:00, off)",
+	"cmd.notify.desc":                 "Your match notification preferences (without options shows the current ones)",
+	"cmd.notify.activo.desc":          "Notify your matches",
+	"cmd.notify.resultado.desc":       "Which matches to notify by result",
+	"cmd.notify.solo_ranked.desc":     "Notify ranked matches only",
+	"cmd.notify.solo_destacadas.desc": "Notify highlight matches only",
+	"cmd.notify.mencion.desc":         "Mention you in the notification",
+	"cmd.notify.md.desc":              "Also send you a copy by direct message",
+	"choice.resultado.all":            "All",
+	"choice.resultado.win":            "Wins",
+	"choice.resultado.loss":           "Losses",
+	"cmd.language.desc":               "Language of the bot's replies",
+	"cmd.language.idioma.desc":        "es, en or auto (your Discord client's language)",
+	"cmd.language.servidor.desc":      "Change the server's default language (requires Manage Server)",
+	"opt.usuario.desc":                "Registered user (optional, defaults to you)",
+	"opt.rol.desc":                    "Filter by role",
+	"opt.linea.desc":                  "Filter by lane",
+	"opt.modo.desc":                   "Filter by game mode",
+	"opt.desde.desc":                  "From date (YYYY-MM-DD)",
+	"opt.hasta.desc":                  "To date, inclusive (YYYY-MM-DD)",
+	"opt.parche.desc":                 "Current patch only (last %d days)",
+	"choice.metrica.winrate":          "Win %",
+	"choice.metrica.partidas":         "Games",
+	"choice.metrica.racha":            "Streak",
+	"choice.metrica.rango":            "Rank",
+	"choice.periodo.dia":              "Day",
+	"choice.periodo.semana":           "Week",
+	"choice.periodo.mes":              "Month",
+	"opt.nombre.name":                 "name",
+	"opt.usuario.name":                "user",
+	"opt.canal.name":                  "channel",
+	"opt.heroe.name":                  "hero",
+	"opt.todos.name":                  "all",
+	"opt.rol.name":                    "role",
+	"opt.linea.name":                  "lane",
+	"opt.modo.name":                   "mode",
+	"opt.desde.name":                  "from",
+	"opt.hasta.name":                  "to",
+	"opt.parche.name":                 "patch",
+	"opt.jugador.name":                "player",
+	"opt.privado.name":                "private",
+	"opt.cantidad.name":               "count",
+	"opt.metrica.name":                "metric",
+	"opt.periodo.name":                "period",
+	"opt.modo_id.name":                "mode_id",
+	"opt.resultado.name":              "result",
+	"opt.destacada.name":              "highlight",
+	"opt.numero.name":                 "number",
+	"opt.zona.name":                   "timezone",
+	"opt.clave.name":                  "key",
+	"opt.valor.name":                  "value",
+	"opt.activo.name":                 "enabled",
+	"opt.solo_ranked.name":            "ranked_only",
+	"opt.solo_destacadas.name":        "highlights_only",
+	"opt.mencion.name":                "mention",
+	"opt.md.name":                     "dm",
+	"opt.idioma.name":                 "language",
+	"opt.servidor.name":               "server",
+}
@@ -0,0 +1,353 @@
+package i18n
+
+// bundleES es el catálogo en español (idioma por defecto: toda clave debe existir aquí).
+var bundleES = map[string]string{
+	"lang.name": "Español",
+
+	"err.invalid_command":       "❌ Comando inválido. Usa `/dota help` para ver los comandos disponibles.",
+	"err.unknown_command":       "❌ Comando no reconocido. Usa `/dota help` para ver los comandos disponibles.",
+	"err.stratz_not_configured": "❌ Stratz no está configurado.",
+	"err.user_unknown":          "❌ No se pudo identificar al usuario",
+	"err.invalid_account_id":    "❌ account_id inválido",
+	"err.no_users":              "❌ No hay usuarios registrados. Usa `/dota register account_id:<tu_steam_id>` para registrar jugadores.",
+	"err.matches":               "❌ Error obteniendo partidas: %v",
+	"err.need_manage":           "❌ Este comando requiere el permiso Gestionar servidor.",
+	"history.page_error":        "❌ No se pudo actualizar el historial, inténtalo de nuevo.",
+
+	"player.default": "Jugador",
+
+	"search.usage":         "❌ Uso: `/dota search nombre:<nombre>`",
+	"search.not_supported": "🔍 La búsqueda por nombre no está disponible con Stratz.\n\nUsa **Steam ID** (account_id) directamente:\n`/dota register account_id:<tu_steam_id>`\n\nPuedes encontrar tu Steam ID en https://stratz.com (busca tu perfil o partidas).",
+	"search.error":         "❌ Error en la búsqueda: %v",
+	"search.no_results":    "❌ No se encontraron jugadores con ese nombre",
+	"search.title":         "🔍 **Resultados de búsqueda:**\n\n",
+	"search.no_name":       "Sin nombre",
+	"search.last_match":    "   Última partida: %s\n",
+	"search.footer":        "Usa `/dota register account_id:<número>` para registrar un jugador\nO `/dota register account_id:<número> usuario:@amigo` para registrar a otro usuario",
+
+	"register.usage":        "❌ Uso: `/dota register account_id:<account_id>` o `/dota register account_id:<número>` después de una búsqueda",
+	"register.no_search":    "❌ No hay resultados de búsqueda disponibles. Usa `/dota search nombre:<nombre>` primero.",
+	"register.not_number":   "❌ El account_id debe ser un número",
+	"register.verify_error": "❌ Error verificando jugador (Stratz): %v",
+	"register.not_found":    "❌ No se encontró el jugador en Stratz",
+	"register.save_error":   "❌ Error guardando registro",
+	"register.ok":           "✅ **%s** (Discord) asociado con **%s** (Dota 2)\nID de Dota: %s",
+
+	"channel.invalid":    "❌ Canal no válido",
+	"channel.save_error": "❌ Error guardando canal",
+	"channel.ok":         "✅ Canal de notificaciones configurado: <#%s>",
+
+	"stats.hero_not_found":  "❌ Héroe no encontrado: %q",
+	"stats.error":           "❌ Error obteniendo estadísticas: %v",
+	"stats.no_heroes":       "**%s** no tiene héroes con al menos %d partidas en las últimas %d partidas analizadas.",
+	"stats.no_heroes_all":   "Ningún jugador registrado tiene héroes con al menos %d partidas en las últimas %d partidas analizadas.",
+	"stats.filters":         " (filtros: %s)",
+	"stats.title":           "📊 Estadísticas por héroe — %s",
+	"stats.footer":          "%d partidas analizadas • ≥%d partidas por héroe • Stratz",
+	"stats.footer_filtered": "%d partidas analizadas • ≥%d partidas por héroe • %s • Stratz",
+
+	"hero.footer":          "Últimas %d partidas con %s • Stratz",
+	"hero.footer_filtered": "Últimas %d partidas con %s • %s • Stratz",
+	"hero.no_games":        "Sin partidas con %s en las últimas %d partidas.",
+	"hero.games":           "Partidas",
+	"hero.last_played":     "Última vez",
+	"hero.avg_kda":         "K/D/A promedio",
+	"hero.info":            "Héroe",
+	"hero.complexity":      "Complejidad %s",
+	"hero.aliases":         "Apodos: %s",
+
+	"attr.str":     "Fuerza",
+	"attr.agi":     "Agilidad",
+	"attr.int":     "Inteligencia",
+	"attr.all":     "Universal",
+	"attr.unknown": "Desconocido",
+
+	"help.title":       "🎮 Comandos del Bot de Dota 2",
+	"help.description": "Comandos disponibles. Usa register para asociar un Discord ID con un ID de Dota.",
+	"help.search":      "Buscar jugadores por nombre de Steam. Devuelve hasta 10 resultados numerados.\n**Ejemplo:** `/dota search nombre:Desp4irs`",
+	"help.register":    "Asocia el ID de Dota a un usuario de Discord y lo guarda en `data/users.json`.\n- Si omites `usuario`, te registras tú.\n- Usa número tras una búsqueda (1-10) o ID directo.\n**Ejemplos:** `/dota register account_id:136201811` · `/dota register account_id:1` · `/dota register account_id:136201811 usuario:@amigo`",
+	"help.channel":     "Configura el canal para notificaciones automáticas de nuevas partidas.\n**Ejemplo:** `/dota channel canal:#dota-updates`",
+	"help.stats":       "Estadísticas por héroe (W/L, %) con ≥STATS_MIN_GAMES partidas en las últimas STATS_TAKE partidas; por defecto las tuyas. Colores: 🔴 ≤40%, 🟡 40-50%, 🟢 ≥50%.\nCon `heroe` (nombre o apodo, con autocompletado): partidas, W/L, K/D/A y GPM promedio, última vez jugado y atributo/roles/complejidad del héroe. Con `todos:true`: un mensaje por cada registrado.\nFiltros opcionales: `rol` (core/support), `linea` (safe/mid/off), `modo` (ranked/turbo/all pick), `desde`/`hasta` (YYYY-MM-DD) o `parche:true`.",
+	"help.match":       "Reporte de cualquier partida. Sin `jugador` muestra el scoreboard de ambos equipos (K/D/A, net worth, líneas); con `jugador` muestra la misma tarjeta que la notificación automática.\n**Ejemplo:** `/dota match id:8123456789`",
+	"help.last":        "Última partida de un usuario registrado ahora mismo, sin esperar al poller (útil si la notificación espera el parse). Por defecto tú.",
+	"help.history":     "Últimas N partidas (hasta 100): héroe, resultado, K/D/A, duración, modo y link a Stratz. Botones para paginar y menú para abrir cualquier partida completa.",
+	"help.profile":     "Tarjeta de perfil: medalla, W/L histórico, racha actual, top 5 héroes y forma de las últimas 20 partidas (🟩/🟥).",
+	"help.compare":     "Cara a cara en las últimas STATS_TAKE partidas: % victorias, K/D/A, GPM/XPM, daño, fase de línea, favoritos y récord juntos / en contra.",
+	"help.leaderboard": "Ranking de todos los registrados en un solo mensaje por % victorias, KDA, GPM, partidas, racha o rango. % victorias/KDA/GPM exigen ≥STATS_MIN_GAMES partidas en el periodo. 🥇🥈🥉 para el podio.",
+	"help.synergy":     "¿Ganamos más cuando jugamos juntos? % victorias por dúo y por stack (3+) de registrados en el mismo equipo, con el mejor y el peor dúo.",
+	"help.rank":        "Historial de rango: cada cambio de medalla/estrellas registrado con su fecha. Los ascensos y descensos de medalla se anuncian en el canal de notificaciones.",
+	"help.help":        "Mostrar esta ayuda",
+	"help.language":    "Idioma de las respuestas del bot: `es` o `en`. Por defecto se usa el idioma de tu cliente de Discord; `auto` borra tu preferencia. Prioridad: tu preferencia → idioma de tu cliente → idioma del servidor (si tu cliente no está en español ni inglés). Con `servidor:true` (requiere Gestionar servidor) cambia el idioma por defecto del servidor, usado en notificaciones.",
+	"help.template":    "Plantilla de las notificaciones del servidor (respuestas solo visibles para ti). `show`: plantilla actual, vista previa y su JSON. `preset`: compacta, completa o meme. `edit json:<...>`: plantilla propia (`text/template` sobre la partida: `{{.Player.Hero}}`, `{{.Match.Duration}}`, `{{.Streak.Text}}`…). Siempre se muestra una vista previa antes de guardar. `reset`: formato por defecto. Requiere Gestionar servidor salvo `show`.",
+	"help.route":       "Reglas para enviar las notificaciones a otros canales (respuesta solo visible para ti). `add canal:<#canal>` con una o más condiciones: `modo` (ranked/normal/turbo/all pick), `modo_id`, `lobby_id`, `resultado`, `usuario` y `destacada`. Gana la primera regla que coincide; sin coincidencia se usa el canal de notificaciones. `remove numero:<n>`, `list`. Requiere Gestionar servidor salvo `list`.",
+	"help.quiet":       "Horas de silencio del servidor (respuesta solo visible para ti). `set desde:<HH:MM> hasta:<HH:MM> zona:<zona IANA>`: las partidas de esa franja no se notifican una a una sino en un único resumen al terminar (partidas, W/L y mejor partida de cada jugador). `off`, `show`. Requiere Gestionar servidor salvo `show`.",
+	"help.config":      "Configuración del bot sin reiniciar (respuesta solo visible para ti). `get [clave]` muestra el valor actual; `set clave:<variable> valor:<valor>` cambia REFRESH_RATE (1–60 minutos), PARSED (true/false), STATS_MIN_GAMES (≥2), STATS_TAKE (0–100, 0 = 100) STATS_TIME o RECAP_TIME (HH:MM u off). Se aplica al momento y se guarda. Requiere Gestionar servidor.",
+	"help.notify":      "Tus preferencias de notificación (respuesta solo visible para ti): `activo`, `resultado` (todas/victorias/derrotas), `solo_ranked`, `solo_destacadas`, `mencion` y `md` (copia por mensaje directo; requiere aceptar MD de miembros del servidor). Sin opciones muestra las actuales.",
+
+	"welcome.title":       "🤖 Bot de Dota 2 - ¡En línea!",
+	"welcome.description": "El bot está funcionando y monitoreando partidas. Aquí están los comandos disponibles:",
+	"welcome.help":        "Muestra esta lista completa de comandos.",
+	"welcome.search":      "Busca jugadores de Dota 2 por nombre de Steam. Devuelve hasta 10 resultados numerados.\n**Ejemplo:** `/dota search nombre:Desp4irs`",
+	"welcome.register":    "Asocia un usuario de Discord con un ID de Dota 2 y lo guarda en `data/users.json`.\n- Si omites `usuario`, se registra quien ejecuta el comando.\n- Puedes registrar a un amigo con `usuario:@amigo`.\n**Ejemplos:** `/dota register account_id:136201811` · `/dota register account_id:1` · `/dota register account_id:136201811 usuario:@amigo`",
+	"welcome.channel":     "Configura el canal para notificaciones automáticas de nuevas partidas.\n**Ejemplo:** `/dota channel canal:#dota-updates`",
+	"welcome.stats":       "Estadísticas por héroe en el parche actual: W/L y % victorias (héroes con ≥10 partidas).",
+	"welcome.footer":      "El bot verifica nuevas partidas cada 10 minutos automáticamente",
+
+	"language.invalid":      "❌ Idioma no válido: %q (usa es, en o auto)",
+	"language.need_manage":  "❌ Cambiar el idioma del servidor requiere el permiso Gestionar servidor.",
+	"language.guild_only":   "❌ El idioma del servidor solo se puede cambiar desde un servidor.",
+	"language.guild_auto":   "❌ El idioma del servidor debe ser `es` o `en`.",
+	"language.save_error":   "❌ Error guardando idioma",
+	"language.set_user":     "✅ Idioma de tus respuestas: **%s**",
+	"language.cleared_user": "✅ Preferencia borrada: se usará el idioma de tu cliente de Discord (ahora **%s**).",
+	"language.set_guild":    "✅ Idioma por defecto del servidor: **%s**",
+
+	"route.guild_only":         "❌ Las reglas de enrutado son por servidor: usa el comando desde un servidor.",
+	"route.no_condition":       "❌ Indica al menos una condición (modo, modo_id, lobby_id, resultado, usuario o destacada).",
+	"route.too_many":           "❌ Máximo %d reglas por servidor. Borra alguna con `/dota route remove`.",
+	"route.save_error":         "❌ Error guardando reglas de enrutado",
+	"route.added":              "✅ Regla **%d** añadida: %s",
+	"route.removed":            "✅ Regla **%d** borrada: %s",
+	"route.not_found":          "❌ No existe la regla %d. Consulta los números con `/dota route list`.",
+	"route.empty":              "No hay reglas de enrutado: todas las notificaciones van a %s.",
+	"route.list_title":         "🔀 **Reglas de enrutado** (gana la primera que coincide):",
+	"route.fallback":           "Sin coincidencia → %s",
+	"route.cond_mode":          "Modo %s",
+	"route.cond_lobby":         "Lobby %s",
+	"route.cond_win":           "Victoria",
+	"route.cond_loss":          "Derrota",
+	"route.cond_player":        "<@%s>",
+	"route.cond_any_highlight": "Destacada",
+	"route.cond_highlight":     "Destacada `%s`",
+
+	"quiet.guild_only":    "❌ Las horas de silencio son por servidor: usa el comando desde un servidor.",
+	"quiet.invalid_time":  "❌ Hora inválida: %q (usa HH:MM, ej. 01:00)",
+	"quiet.empty_window":  "❌ El inicio y el fin no pueden ser la misma hora.",
+	"quiet.invalid_zone":  "❌ Zona horaria desconocida: %q (usa el nombre IANA, ej. Europe/Madrid)",
+	"quiet.save_error":    "❌ Error guardando horas de silencio",
+	"quiet.saved":         "✅ Horas de silencio: **%s–%s** (%s). Las partidas de esa franja se publicarán en un resumen al terminar.",
+	"quiet.off":           "✅ Horas de silencio desactivadas. Si hay partidas en cola, el resumen se publica en la próxima verificación.",
+	"quiet.none":          "Sin horas de silencio. Partidas en cola: %d.",
+	"quiet.current":       "🌙 Horas de silencio: **%s–%s** (%s) · %s · partidas en cola: %d",
+	"quiet.active":        "activas ahora",
+	"quiet.inactive":      "inactivas ahora",
+	"config.guild_only":   "❌ La configuración se guarda por servidor: usa el comando desde un servidor.",
+	"config.not_home":     "❌ La configuración es única para todo el bot y solo se cambia desde el servidor principal (SERVER_ID o el del canal de notificaciones).",
+	"config.invalid":      "❌ Valor inválido para %s: %v",
+	"config.save_error":   "❌ Error guardando la configuración (no se cambió nada)",
+	"config.saved":        "✅ `%s` = **%s**. Aplicado sin reiniciar.",
+	"config.current":      "⚙️ Configuración actual:",
+	"config.source_env":   ".env",
+	"config.source_saved": "/dota config",
+	"digest.title":        "🌙 Resumen de las horas de silencio",
+	"digest.description":  "%d partida(s) de %d jugador(es) mientras el canal estaba en silencio",
+	"digest.player":       "<@%s> · %d partida(s) · %dV - %dD · ⏱️ %s",
+	"digest.best":         "⭐ Mejor partida",
+
+	"session.title":       "🎮 Fin de la sesión",
+	"session.title_party": "🎮 Fin de la sesión de la party",
+	"session.description": "%d partida(s) · ⏱️ %s · <t:%d:t>–<t:%d:t>",
+	"session.player":      "<@%s> · %d partida(s) · %dV - %dD · ⏱️ %s",
+	"session.rank":        "📊 Rango: %s",
+	"session.rank_same":   "%s (sin cambios)",
+	"session.heroes":      "🦸 %s",
+	"session.mvp":         "⭐ MVP de la noche",
+
+	"notify.not_registered":  "❌ No estás registrado. Usa `/dota register` primero.",
+	"notify.invalid_result":  "❌ Resultado no válido: %q (usa all, win o loss)",
+	"notify.save_error":      "❌ Error guardando preferencias de notificación",
+	"notify.current":         "🔔 Tus notificaciones de partidas:",
+	"notify.saved":           "✅ Preferencias guardadas:",
+	"notify.enabled":         "Activas",
+	"notify.result":          "Resultado",
+	"notify.result_all":      "todas",
+	"notify.result_win":      "solo victorias",
+	"notify.result_loss":     "solo derrotas",
+	"notify.ranked_only":     "Solo ranked",
+	"notify.highlights_only": "Solo destacadas",
+	"notify.mention":         "Mencionarte",
+	"notify.dm":              "Copia por MD",
+	"notify.yes":             "sí",
+	"notify.no":              "no",
+
+	"match.unparsed":     "⏳ Aún sin parsear en Stratz",
+	"match.win":          "✅ Victoria",
+	"match.loss":         "❌ Derrota",
+	"match.duration":     "Duración",
+	"match.level":        "Nivel",
+	"match.mode":         "Modo",
+	"match.hero_record":  "Record con %s (últ. 20)",
+	"match.lane_role":    "Lane / Rol",
+	"match.lane_results": "Resultado por línea",
+	"match.players":      "👥 Jugadores (Perfiles Públicos)",
+	"match.and_more":     "... y más",
+	"match.streak_win":   "%d victorias consecutivas 🔥",
+	"match.streak_loss":  "%d derrotas consecutivas 💀",
+	"match.mvp":          "🏅 MVP",
+	"match.worst":        "💩 Peor actuación",
+
+	"lane.radiant_victory": "Victoria Radiant",
+	"lane.radiant_stomp":   "Stomp Radiant",
+	"lane.dire_victory":    "Victoria Dire",
+	"lane.dire_stomp":      "Stomp Dire",
+	"lane.tie":             "Empate",
+	"lane.you":             "%s (tú)",
+	"lane.phase_tie":       "*Empate en fase de línea*",
+	"lane.phase_win":       "*✅ Victoria en fase de línea*",
+	"lane.phase_loss":      "*❌ Derrota en fase de línea*",
+
+	"template.guild_only":      "❌ Las plantillas son por servidor: usa el comando desde un servidor.",
+	"template.current_default": "Plantilla actual: **por defecto**. Elige una con `/dota template preset` (%s) o crea la tuya con `/dota template edit`.",
+	"template.current_preset":  "Plantilla actual: preset **%s**. El JSON adjunto sirve de punto de partida para `/dota template edit`.",
+	"template.current_custom":  "Plantilla actual: **personalizada**. Para cambiarla edita el JSON adjunto y pégalo en `/dota template edit`.",
+	"template.unknown_preset":  "❌ Preset desconocido: %q (usa %s)",
+	"template.invalid_json":    "❌ JSON inválido: %v",
+	"template.invalid":         "❌ La plantilla no es válida: %v",
+	"template.preview":         "👀 Vista previa con una partida de ejemplo. ¿Guardar como plantilla de notificaciones del servidor?",
+	"template.save":            "Guardar",
+	"template.cancel":          "Cancelar",
+	"template.saved":           "✅ Plantilla guardada: las próximas notificaciones usarán este formato.",
+	"template.cancelled":       "Cambios descartados.",
+	"template.expired":         "❌ Esta vista previa ya no está disponible. Vuelve a ejecutar el comando.",
+	"template.save_error":      "❌ Error guardando plantilla",
+	"template.reset":           "✅ Plantilla restablecida: las notificaciones usan el formato por defecto.",
+	"template.sample_player":   "Jugador de ejemplo",
+	"tpl.meme.win_title":       "🐐 %s se echó el equipo a la espalda",
+	"tpl.meme.loss_title":      "🤡 %s alimentó al enemigo",
+	"tpl.meme.feeder":          "☠️ %d muertes. Eso no es un KDA, es un número de teléfono.",
+	"tpl.meme.deathless":       "😇 Cero muertes. El enemigo ni sabía que existía.",
+	"tpl.meme.win":             "GG EZ con %s. Ni sudó.",
+	"tpl.meme.loss":            "Culpa del equipo, obviamente. (%s)",
+	"tpl.meme.kda":             "**%d/%d/%d** en %s",
+
+	"common.no_matches":       "Sin partidas",
+	"compare.usage":           "❌ Uso: `/dota compare a:@usuario b:@usuario`",
+	"compare.same_user":       "❌ Elige dos usuarios distintos",
+	"err.not_registered":      "❌ **%s** no está registrado. Usa `/dota register account_id:<id> usuario:@%s`",
+	"err.invalid_account_for": "❌ account_id inválido para **%s**",
+	"compare.matches_error":   "❌ Error obteniendo partidas de **%s**: %v",
+	"compare.winrate":         "% victorias",
+	"compare.hero_damage":     "Daño a héroes",
+	"compare.lane":            "Fase de línea",
+	"compare.metric_tie":      "%s: empate",
+	"compare.winner":          "🏆 **%s** gana %d-%d",
+	"compare.tie":             "🤝 Empate %d-%d",
+	"compare.no_common":       "Sin partidas en común",
+	"compare.together":        "🤝 Juntos: %d-%d (%.1f%%)",
+	"compare.against":         "⚔️ En contra: %s %d - %d %s",
+	"compare.h2h":             "Juntos / En contra",
+	"compare.verdict":         "Veredicto",
+	"compare.footer":          "Últimas %d partidas de cada jugador • Stratz",
+	"compare.col_games":       "**Partidas:** %d (%d-%d, %.1f%%)",
+	"compare.col_damage":      "**Daño a héroes:** %s",
+	"compare.col_lane":        "**Fase de línea:** %s",
+	"compare.col_favorites":   "**Favoritos:** %s",
+
+	"err.no_recent":           "❌ **%s** no tiene partidas recientes en Stratz",
+	"err.match":               "❌ Error obteniendo la partida %d",
+	"err.match_detail":        "❌ Error obteniendo la partida %d: %v",
+	"err.match_not_found":     "❌ No se encontró la partida %d en Stratz",
+	"err.player_not_in_match": "❌ Jugador %s no encontrado en partida %d",
+	"match.usage":             "❌ Uso: `/dota match id:<match_id> [jugador:@usuario]`",
+	"match.not_played":        "❌ **%s** no jugó la partida %d",
+	"match.title":             "Partida %d",
+	"match.your_team":         "tu equipo",
+	"scoreboard.anonymous":    "Anónimo",
+	"scoreboard.player_id":    "Jugador %d",
+	"scoreboard.player":       "Jugador",
+	"scoreboard.damage":       "Daño",
+	"graph.legend":            "📈 Ventaja: 🟡 oro · 🔵 XP (arriba = %s)",
+	"history.win":             "Victoria",
+	"history.loss":            "Derrota",
+	"history.title":           "📜 Historial — %s",
+	"history.footer":          "Página %d/%d • %d partidas • Stratz",
+	"history.previous":        "◀ Anterior",
+	"history.next":            "Siguiente ▶",
+	"history.select":          "Ver partida completa…",
+
+	"leaderboard.usage":           "❌ Uso: `/dota leaderboard metrica:<winrate|kda|gpm|partidas|racha|rango> periodo:<dia|semana|mes>`",
+	"leaderboard.period.dia":      "últimas 24 horas",
+	"leaderboard.period.semana":   "últimos 7 días",
+	"leaderboard.period.mes":      "últimos 30 días",
+	"leaderboard.metric.winrate":  "% victorias",
+	"leaderboard.metric.kda":      "KDA",
+	"leaderboard.metric.gpm":      "GPM promedio",
+	"leaderboard.metric.partidas": "Partidas jugadas",
+	"leaderboard.metric.racha":    "Racha actual",
+	"leaderboard.metric.rango":    "Rango",
+	"leaderboard.no_data":         "Ningún jugador registrado tiene datos suficientes (≥%d partidas) en los %s.",
+	"leaderboard.players":         "%d jugadores",
+	"leaderboard.below_min":       "%d con menos de %d partidas omitidos",
+
+	"rank.uncalibrated":      "Sin calibrar",
+	"profile.error":          "❌ Error obteniendo perfil (Stratz): %v",
+	"profile.lifetime":       "%d-%d (%.1f%%) • %d partidas",
+	"profile.form":           "%s\n%d-%d (%.0f%%) • antigua → reciente",
+	"profile.no_heroes":      "Sin héroes con ≥%d partidas",
+	"profile.title":          "👤 Perfil — %s",
+	"profile.rank":           "Rango",
+	"profile.lifetime_title": "W/L histórico",
+	"profile.streak":         "Racha actual",
+	"profile.form_title":     "Forma (últ. %d)",
+	"profile.top_heroes":     "Top 5 héroes",
+	"profile.footer":         "Héroes: últimas %d partidas • ≥%d partidas por héroe • Stratz",
+
+	"rank.demoted":       "📉 Descenso de medalla",
+	"rank.promoted":      "🎉 ¡Ascenso de medalla!",
+	"rank.change_footer": "Usa /dota rank para ver el historial • Stratz",
+	"rank.no_history":    "**%s** aún no tiene rango registrado (¿perfil privado o sin calibrar?).",
+	"rank.footer":        "%d cambios registrados desde %s",
+	"rank.stars":         "%+d estrellas",
+	"rank.title":         "📈 Historial de rango — %s",
+	"rank.current":       "Actual: **%s**\n\n%s",
+
+	"recap.semanal.title":  "📅 Resumen semanal",
+	"recap.semanal.label":  "últimos 7 días",
+	"recap.mensual.title":  "🗓️ Resumen mensual",
+	"recap.mensual.label":  "último mes",
+	"recap.description":    "%d partidas de %d jugadores en los %s",
+	"recap.player":         "%s — %d partidas (%d-%d, %.1f%%)",
+	"recap.players":        "Partidas por jugador",
+	"recap.best":           "🌟 Mejor partida (KDA)",
+	"recap.worst":          "💩 Peor partida (KDA)",
+	"recap.hero":           "**%s** — %d partidas (%.1f%%)",
+	"recap.heroes":         "🦸 Héroes más jugados",
+	"recap.longest_streak": "Racha más larga",
+	"recap.rank_change":    "Mayor cambio de rango",
+	"recap.no_rank_change": "Sin cambios de rango",
+	"recap.match_link":     "partida",
+	"recap.footer":         "Mejor/peor partida por KDA • sinergia con ≥%d partidas juntos • Stratz",
+
+	"synergy.need_two":     "❌ Se necesitan al menos 2 usuarios registrados para calcular sinergias.",
+	"synergy.title_group":  "🤝 Sinergia del grupo",
+	"synergy.title_player": "🤝 Sinergia de %s",
+	"synergy.none":         "No hay partidas con 2 o más registrados en el mismo equipo en las últimas %d partidas.",
+	"synergy.footer":       "Últimas %d partidas de cada registrado • mejor/peor dúo con ≥%d partidas juntos • Stratz",
+	"synergy.description":  "%d partidas con 2 o más registrados en el mismo equipo",
+	"synergy.best":         "🔥 Mejor dúo",
+	"synergy.worst":        "💀 Peor dúo",
+	"synergy.pairs":        "Dúos",
+
+	"filter.role":         "Rol: %s",
+	"filter.lane":         "Línea: %s",
+	"filter.mode":         "Modo: %s",
+	"filter.invalid_date": "❌ Fecha `%s` inválida (%q), usa YYYY-MM-DD",
+	"filter.from":         "Desde %s",
+	"filter.to":           "Hasta %s",
+	"filter.patch":        "Parche actual (%d días)",
+	"filter.bad_range":    "❌ `desde` debe ser anterior a `hasta`",
+
+	"match.items": "Items",
+
+	"highlight.kills":          "🔪 %d kills",
+	"highlight.deathless":      "🛡️ Sin morir",
+	"highlight.gpm":            "💰 %d GPM",
+	"highlight.comeback":       "🔄 Remontada: línea perdida por stomp y partida ganada",
+	"highlight.longest_game":   "⏳ Partida más larga del mes (%s)",
+	"highlight.win_streak":     "🔥 %d victorias seguidas",
+	"highlight.loss_streak":    "💀 %d derrotas seguidas",
+	"highlight.hero_damage_pb": "📈 Récord de daño a héroes: %d (últ. %d partidas)",
+	"highlight.healing_pb":     "💚 Récord de curación: %d (últ. %d partidas)",
+	"scoring.weights":          "KDA %.0f, daño %.0f, torres %.0f, curación %.0f, GPM %.0f",
+	"scoring.description":      "MVP: %% del máximo de la partida por métrica • Core: %s • Support: %s",
+}
@@ -0,0 +1,61 @@
+// Package i18n contiene los textos del bot en cada idioma soportado (es, en).
+// El español es el idioma por defecto: cualquier clave que falte en otro idioma cae al español,
+// y si tampoco existe se devuelve la clave tal cual para que el hueco se note.
+package i18n
+
+import (
+	"fmt"
+	"strings"
+)
+
+// Lang es un idioma soportado (código ISO 639-1)
+type Lang string
+
+const (
+	Spanish Lang = "es"
+	English Lang = "en"
+
+	// Default es el idioma usado cuando no hay preferencia ni locale reconocido
+	Default = Spanish
+)
+
+// Supported son los idiomas con catálogo, en el orden en que se ofrecen
+var Supported = []Lang{Spanish, English}
+
+var bundles = map[Lang]map[string]string{
+	Spanish: bundleES,
+	English: bundleEN,
+}
+
+// Parse convierte un código de idioma o locale de Discord (es, es-ES, es-419, en, en-US, en-GB…) en Lang
+func Parse(s string) (Lang, bool) {
+	base, _, _ := strings.Cut(strings.ToLower(strings.TrimSpace(s)), "-")
+	lang := Lang(base)
+	_, ok := bundles[lang]
+	return lang, ok
+}
+
+// Name devuelve el nombre del idioma en ese mismo idioma (Español, English)
+func (l Lang) Name() string {
+	return T(l, "lang.name")
+}
+
+// T devuelve el texto de key en lang (o en Default si falta); con args se formatea con fmt.Sprintf
+func T(lang Lang, key string, args ...interface{}) string {
+	text, ok := bundles[lang][key]
+	if !ok {
+		if text, ok = bundleES[key]; !ok {
+			text = key
+		}
+	}
+	if len(args) > 0 {
+		return fmt.Sprintf(text, args...)
+	}
+	return text
+}
+
+// Has indica si lang tiene su propia traducción de key (sin caer al idioma por defecto)
+func Has(lang Lang, key string) bool {
+	_, ok := bundles[lang][key]
+	return ok
+}
@@ -55,6 +55,14 @@ func main() {
 
 	// Cliente OpenDota: código conservado pero no se usa (solo Stratz)
 	dotaClient := dota.NewClient()
+	if cfg.ConstantsDir != "" {
+		constants, err := dota.LoadConstants(cfg.ConstantsDir)
+		if err != nil {
+			logrus.Fatalf("Error cargando constantes de %s: %v", cfg.ConstantsDir, err)
+		}
+		dotaClient.SetConstants(constants)
+		logrus.Infof("Constantes cargadas de %s (los archivos que falten se usan embebidos)", cfg.ConstantsDir)
+	}
 
 	// Stratz es obligatorio: el bot usa solo la API de Stratz
 	if cfg.StratzToken == "" {
@@ -93,22 +101,28 @@ func main() {
 		}
 	}()
 
-	// Configurar polling cada REFRESH_RATE minutos (por defecto 1)
-	ticker := time.NewTicker(time.Duration(cfg.RefreshRateMinutes) * time.Minute)
+	// Configurar polling cada REFRESH_RATE minutos (por defecto 1; se puede cambiar en caliente con /dota config set)
+	ticker := time.NewTicker(time.Duration(bot.RefreshRate()) * time.Minute)
 	defer ticker.Stop()
-	logrus.Infof("Verificación de partidas cada %d minuto(s)", cfg.RefreshRateMinutes)
+	logrus.Infof("Verificación de partidas cada %d minuto(s)", bot.RefreshRate())
 
 	// Loop de polling
 	go func() {
-		for range ticker.C {
-			logrus.Debug("Ejecutando verificación periódica de partidas...")
-			if err := bot.CheckForNewMatches(); err != nil {
-				logrus.Errorf("Error verificando partidas: %v", err)
+		for {
+			select {
+			case <-ticker.C:
+				logrus.Debug("Ejecutando verificación periódica de partidas...")
+				if err := bot.CheckForNewMatches(); err != nil {
+					logrus.Errorf("Error verificando partidas: %v", err)
+				}
+			case minutes := <-bot.RefreshRateChanges():
+				ticker.Reset(time.Duration(minutes) * time.Minute)
+				logrus.Infof("Verificación de partidas cada %d minuto(s)", minutes)
 			}
 		}
 	}()
 
-	// Scheduler diario de stats (STATS_TIME en .env, ej. 20:00)
+	// Scheduler diario de stats (STATS_TIME) y recaps semanal/mensual (RECAP_TIME)
 	go bot.RunStatsScheduler()
 
 	// Esperar señal de interrupción
@@ -0,0 +1,191 @@
+// Package render genera imágenes PNG (gráficas, scoreboards) con la librería estándar y golang.org/x/image (fuente bitmap y escalado).
+package render
+
+import (
+	"bytes"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+)
+
+// Tamaño y colores de la gráfica de ventaja
+const (
+	advantageWidth  = 800
+	advantageHeight = 300
+	advantageMargin = 16
+	minutesPerGrid  = 10
+	minAdvantage    = 2000 // escala mínima (oro/XP) para que partidas igualadas no se vean exageradas
+)
+
+var (
+	colorBackground = color.RGBA{0x2f, 0x31, 0x36, 0xff} // fondo de Discord (modo oscuro)
+	colorGrid       = color.RGBA{0x40, 0x44, 0x4b, 0xff}
+	colorZero       = color.RGBA{0x8e, 0x92, 0x97, 0xff}
+	colorAhead      = color.RGBA{0x2e, 0xcc, 0x71, 0x50} // verde translúcido: ventaja del equipo del jugador
+	colorBehind     = color.RGBA{0xe7, 0x4c, 0x3c, 0x50} // rojo translúcido: ventaja rival
+	colorGold       = color.RGBA{0xf1, 0xc4, 0x0f, 0xff}
+	colorXP         = color.RGBA{0x34, 0x98, 0xdb, 0xff}
+)
+
+// AdvantageGraph dibuja las curvas de ventaja de oro y XP por minuto desde la perspectiva del equipo del jugador:
+// arriba del cero = su equipo va por delante (área verde), abajo = va por detrás (área roja).
+// goldAdv y xpAdv son ventajas de Radiant (como las devuelve Stratz); radiant indica el equipo del jugador.
+// Oro en amarillo, XP en azul; una línea vertical cada 10 minutos.
+func AdvantageGraph(goldAdv, xpAdv []int, radiant bool) ([]byte, error) {
+	if len(goldAdv) < 2 {
+		return nil, fmt.Errorf("sin datos de ventaja (partida no parseada)")
+	}
+	sign := 1
+	if !radiant {
+		sign = -1
+	}
+	gold := make([]int, len(goldAdv))
+	for i, v := range goldAdv {
+		gold[i] = sign * v
+	}
+	var xp []int
+	if len(xpAdv) >= 2 {
+		xp = make([]int, len(xpAdv))
+		for i, v := range xpAdv {
+			xp[i] = sign * v
+		}
+	}
+
+	scale := minAdvantage
+	for _, series := range [][]int{gold, xp} {
+		for _, v := range series {
+			if abs(v) > scale {
+				scale = abs(v)
+			}
+		}
+	}
+	minutes := len(gold) - 1
+	if len(xp)-1 > minutes {
+		minutes = len(xp) - 1
+	}
+
+	img := image.NewRGBA(image.Rect(0, 0, advantageWidth, advantageHeight))
+	draw.Draw(img, img.Bounds(), &image.Uniform{colorBackground}, image.Point{}, draw.Src)
+
+	plotW := advantageWidth - 2*advantageMargin
+	plotH := advantageHeight - 2*advantageMargin
+	zeroY := advantageMargin + plotH/2
+	xAt := func(minute float64) int {
+		return advantageMargin + int(minute*float64(plotW)/float64(minutes))
+	}
+	yAt := func(v float64) int {
+		return zeroY - int(v*float64(plotH/2)/float64(scale))
+	}
+
+	for m := minutesPerGrid; m < minutes; m += minutesPerGrid {
+		x := xAt(float64(m))
+		for y := advantageMargin; y < advantageHeight-advantageMargin; y++ {
+			img.Set(x, y, colorGrid)
+		}
+	}
+
+	// Área entre la curva de oro y el cero, interpolando por columna de píxeles
+	for x := advantageMargin; x <= advantageMargin+plotW; x++ {
+		minute := float64(x-advantageMargin) * float64(minutes) / float64(plotW)
+		v, ok := interpolate(gold, minute)
+		if !ok {
+			continue
+		}
+		y := yAt(v)
+		fill := colorAhead
+		from, to := y, zeroY
+		if y > zeroY {
+			fill = colorBehind
+			from, to = zeroY, y
+		}
+		for yy := from; yy <= to; yy++ {
+			blend(img, x, yy, fill)
+		}
+	}
+
+	for x := advantageMargin; x <= advantageMargin+plotW; x++ {
+		img.Set(x, zeroY, colorZero)
+	}
+
+	drawSeries(img, xp, xAt, yAt, colorXP)
+	drawSeries(img, gold, xAt, yAt, colorGold)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("error codificando PNG: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// drawSeries dibuja la serie como una polilínea de 3 px de grosor.
+func drawSeries(img *image.RGBA, series []int, xAt func(float64) int, yAt func(float64) int, c color.RGBA) {
+	for i := 1; i < len(series); i++ {
+		drawLine(img, xAt(float64(i-1)), yAt(float64(series[i-1])), xAt(float64(i)), yAt(float64(series[i])), c)
+	}
+}
+
+// drawLine dibuja una línea (Bresenham) con un punto de 3x3 px en cada paso.
+func drawLine(img *image.RGBA, x0, y0, x1, y1 int, c color.RGBA) {
+	dx := abs(x1 - x0)
+	dy := -abs(y1 - y0)
+	sx, sy := 1, 1
+	if x0 > x1 {
+		sx = -1
+	}
+	if y0 > y1 {
+		sy = -1
+	}
+	err := dx + dy
+	for {
+		for ox := -1; ox <= 1; ox++ {
+			for oy := -1; oy <= 1; oy++ {
+				img.Set(x0+ox, y0+oy, c)
+			}
+		}
+		if x0 == x1 && y0 == y1 {
+			return
+		}
+		e2 := 2 * err
+		if e2 >= dy {
+			err += dy
+			x0 += sx
+		}
+		if e2 <= dx {
+			err += dx
+			y0 += sy
+		}
+	}
+}
+
+// interpolate devuelve el valor de la serie en un minuto fraccional (ok=false fuera de rango).
+func interpolate(series []int, minute float64) (float64, bool) {
+	if len(series) == 0 || minute < 0 || minute > float64(len(series)-1) {
+		return 0, false
+	}
+	i := int(minute)
+	if i >= len(series)-1 {
+		return float64(series[len(series)-1]), true
+	}
+	frac := minute - float64(i)
+	return float64(series[i]) + frac*float64(series[i+1]-series[i]), true
+}
+
+// blend mezcla c (con alfa) sobre el píxel existente.
+func blend(img *image.RGBA, x, y int, c color.RGBA) {
+	if !(image.Point{x, y}.In(img.Rect)) {
+		return
+	}
+	dst := img.RGBAAt(x, y)
+	a := uint32(c.A)
+	mix := func(s, d uint8) uint8 { return uint8((uint32(s)*a + uint32(d)*(255-a)) / 255) }
+	img.SetRGBA(x, y, color.RGBA{mix(c.R, dst.R), mix(c.G, dst.G), mix(c.B, dst.B), 0xff})
+}
+
+func abs(n int) int {
+	if n < 0 {
+		return -n
+	}
+	return n
+}
@@ -0,0 +1,222 @@
+package render
+
+import (
+	"bytes"
+	"dota-discord-bot/i18n"
+	"fmt"
+	"image"
+	"image/color"
+	"image/draw"
+	"image/png"
+	"os"
+	"path/filepath"
+	"strings"
+
+	xdraw "golang.org/x/image/draw"
+	"golang.org/x/image/font"
+	"golang.org/x/image/font/basicfont"
+	"golang.org/x/image/math/fixed"
+)
+
+// Tamaño y columnas del scoreboard
+const (
+	scoreboardWidth     = 760
+	scoreboardPadding   = 12
+	scoreboardTitleH    = 36
+	scoreboardHeaderH   = 26
+	scoreboardRowH      = 40
+	scoreboardIconW     = 57 // 16:9 como los renders de héroes
+	scoreboardIconH     = 32
+	scoreboardNameChars = 22
+	colHero             = scoreboardPadding + scoreboardIconW + 10
+	colPlayer           = 240
+	colKDA              = 450
+	colGPM              = 560
+	colDamage           = 640
+)
+
+var (
+	colorText        = color.RGBA{0xdc, 0xdd, 0xde, 0xff}
+	colorTextDim     = color.RGBA{0x96, 0x98, 0x9d, 0xff}
+	colorRadiant     = color.RGBA{0x2e, 0xcc, 0x71, 0xff}
+	colorDire        = color.RGBA{0xe7, 0x4c, 0x3c, 0xff}
+	colorRowAlt      = color.RGBA{0x36, 0x39, 0x3f, 0xff}
+	colorHighlight   = color.RGBA{0xf1, 0xc4, 0x0f, 0x40} // fondo de jugadores registrados
+	colorHighlightEd = color.RGBA{0xf1, 0xc4, 0x0f, 0xff} // borde izquierdo de jugadores registrados
+	colorIconMissing = color.RGBA{0x20, 0x22, 0x25, 0xff}
+)
+
+// ScoreboardRow es una fila (jugador) del scoreboard
+type ScoreboardRow struct {
+	HeroIcon   image.Image // nil = recuadro vacío
+	HeroName   string
+	PlayerName string
+	Kills      int
+	Deaths     int
+	Assists    int
+	GPM        int
+	HeroDamage int
+	Highlight  bool // jugador registrado
+}
+
+// Scoreboard son los datos de la imagen: ambos equipos y el marcador
+type Scoreboard struct {
+	RadiantWin   bool
+	RadiantScore int
+	DireScore    int
+	Radiant      []ScoreboardRow
+	Dire         []ScoreboardRow
+	Lang         i18n.Lang // idioma del marcador y las cabeceras
+}
+
+// LoadHeroIcon carga la miniatura local de un héroe ({slug}_icon.png y, si no existe, {slug}.png) desde dir
+// (descargadas con cmd/download_hero_images). Devuelve nil si no hay imagen.
+func LoadHeroIcon(dir, slug string) image.Image {
+	if slug == "" {
+		return nil
+	}
+	for _, name := range []string{slug + "_icon.png", slug + ".png"} {
+		f, err := os.Open(filepath.Join(dir, name))
+		if err != nil {
+			continue
+		}
+		img, _, err := image.Decode(f)
+		f.Close()
+		if err == nil {
+			return img
+		}
+	}
+	return nil
+}
+
+// ScoreboardPNG dibuja el scoreboard de 10 jugadores: icono, héroe, jugador, K/D/A, GPM y daño a héroes,
+// con los jugadores registrados resaltados.
+func ScoreboardPNG(sb Scoreboard) ([]byte, error) {
+	if len(sb.Radiant)+len(sb.Dire) == 0 {
+		return nil, fmt.Errorf("scoreboard sin jugadores")
+	}
+	height := scoreboardTitleH + 2*scoreboardHeaderH + (len(sb.Radiant)+len(sb.Dire))*scoreboardRowH + scoreboardPadding
+	img := image.NewRGBA(image.Rect(0, 0, scoreboardWidth, height))
+	draw.Draw(img, img.Bounds(), &image.Uniform{colorBackground}, image.Point{}, draw.Src)
+
+	winner := i18n.T(sb.Lang, "lane.dire_victory")
+	if sb.RadiantWin {
+		winner = i18n.T(sb.Lang, "lane.radiant_victory")
+	}
+	drawText(img, scoreboardPadding, 24, fmt.Sprintf("Radiant %d - %d Dire  |  %s", sb.RadiantScore, sb.DireScore, winner), colorText)
+
+	y := scoreboardTitleH
+	y = drawTeam(img, sb.Lang, y, "RADIANT", colorRadiant, sb.Radiant)
+	drawTeam(img, sb.Lang, y, "DIRE", colorDire, sb.Dire)
+
+	var buf bytes.Buffer
+	if err := png.Encode(&buf, img); err != nil {
+		return nil, fmt.Errorf("error codificando PNG: %w", err)
+	}
+	return buf.Bytes(), nil
+}
+
+// drawTeam dibuja la cabecera y las filas de un equipo a partir de y; devuelve la y siguiente.
+func drawTeam(img *image.RGBA, lang i18n.Lang, y int, name string, teamColor color.RGBA, rows []ScoreboardRow) int {
+	fillRect(img, image.Rect(0, y, scoreboardWidth, y+2), teamColor)
+	baseline := y + 18
+	drawText(img, scoreboardPadding, baseline, name, teamColor)
+	drawText(img, colPlayer, baseline, i18n.T(lang, "scoreboard.player"), colorTextDim)
+	drawText(img, colKDA, baseline, "K/D/A", colorTextDim)
+	drawText(img, colGPM, baseline, "GPM", colorTextDim)
+	drawText(img, colDamage, baseline, i18n.T(lang, "scoreboard.damage"), colorTextDim)
+	y += scoreboardHeaderH
+
+	for idx, row := range rows {
+		rect := image.Rect(0, y, scoreboardWidth, y+scoreboardRowH)
+		if idx%2 == 1 {
+			fillRect(img, rect, colorRowAlt)
+		}
+		if row.Highlight {
+			for yy := rect.Min.Y; yy < rect.Max.Y; yy++ {
+				for xx := rect.Min.X; xx < rect.Max.X; xx++ {
+					blend(img, xx, yy, colorHighlight)
+				}
+			}
+			fillRect(img, image.Rect(0, y, 4, y+scoreboardRowH), colorHighlightEd)
+		}
+
+		iconRect := image.Rect(scoreboardPadding, y+(scoreboardRowH-scoreboardIconH)/2, scoreboardPadding+scoreboardIconW, y+(scoreboardRowH+scoreboardIconH)/2)
+		if row.HeroIcon != nil {
+			xdraw.ApproxBiLinear.Scale(img, fitRect(iconRect, row.HeroIcon.Bounds()), row.HeroIcon, row.HeroIcon.Bounds(), draw.Over, nil)
+		} else {
+			fillRect(img, iconRect, colorIconMissing)
+		}
+
+		textY := y + scoreboardRowH/2 + 5
+		nameColor := colorText
+		if row.Highlight {
+			nameColor = colorHighlightEd
+		}
+		drawText(img, colHero, textY, truncateRunes(row.HeroName, scoreboardNameChars), colorText)
+		drawText(img, colPlayer, textY, truncateRunes(row.PlayerName, scoreboardNameChars), nameColor)
+		drawText(img, colKDA, textY, fmt.Sprintf("%d/%d/%d", row.Kills, row.Deaths, row.Assists), colorText)
+		drawText(img, colGPM, textY, fmt.Sprintf("%d", row.GPM), colorText)
+		drawText(img, colDamage, textY, fmt.Sprintf("%d", row.HeroDamage), colorText)
+		y += scoreboardRowH
+	}
+	return y
+}
+
+// fitRect devuelve el rectángulo centrado dentro de box que conserva la proporción de src.
+func fitRect(box, src image.Rectangle) image.Rectangle {
+	bw, bh := box.Dx(), box.Dy()
+	sw, sh := src.Dx(), src.Dy()
+	if sw == 0 || sh == 0 {
+		return box
+	}
+	w, h := bw, sh*bw/sw
+	if h > bh {
+		w, h = sw*bh/sh, bh
+	}
+	x := box.Min.X + (bw-w)/2
+	y := box.Min.Y + (bh-h)/2
+	return image.Rect(x, y, x+w, y+h)
+}
+
+// drawText escribe texto con la fuente bitmap 7x13 (sin dependencias de fuentes del sistema).
+func drawText(img *image.RGBA, x, baseline int, text string, c color.RGBA) {
+	d := &font.Drawer{
+		Dst:  img,
+		Src:  image.NewUniform(c),
+		Face: basicfont.Face7x13,
+		Dot:  fixed.P(x, baseline),
+	}
+	d.DrawString(asciiText(text))
+}
+
+// asciiReplacer translitera los acentos más comunes; la fuente bitmap solo tiene glifos ASCII.
+var asciiReplacer = strings.NewReplacer(
+	"á", "a", "é", "e", "í", "i", "ó", "o", "ú", "u", "ü", "u", "ñ", "n",
+	"Á", "A", "É", "E", "Í", "I", "Ó", "O", "Ú", "U", "Ü", "U", "Ñ", "N",
+	"à", "a", "è", "e", "ì", "i", "ò", "o", "ù", "u", "ç", "c", "ö", "o", "ä", "a",
+)
+
+// asciiText translitera text a ASCII y sustituye el resto de caracteres por '?'.
+func asciiText(text string) string {
+	text = asciiReplacer.Replace(text)
+	return strings.Map(func(r rune) rune {
+		if r < 0x20 || r > 0x7e {
+			return '?'
+		}
+		return r
+	}, text)
+}
+
+func fillRect(img *image.RGBA, r image.Rectangle, c color.RGBA) {
+	draw.Draw(img, r, &image.Uniform{c}, image.Point{}, draw.Src)
+}
+
+// truncateRunes recorta s a n caracteres (con "..." si se recorta).
+func truncateRunes(s string, n int) string {
+	r := []rune(s)
+	if len(r) <= n {
+		return s
+	}
+	return string(r[:n-3]) + "..."
+}
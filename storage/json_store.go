@@ -8,19 +8,137 @@ import (
 )
 
 type UserStore struct {
-	mu           sync.RWMutex
-	users        map[string]string // discord_id -> dota_account_id
-	lastMatches  map[string]int64  // discord_id -> last_match_id
-	usersFile    string
-	matchesFile  string
+	mu          sync.RWMutex
+	users       map[string]string         // discord_id -> dota_account_id
+	lastMatches map[string]int64          // discord_id -> last_match_id
+	sessions    map[string]int64          // discord_id -> última partida de la última sesión resumida
+	rankHistory map[string][]RankSnapshot // dota_account_id -> cambios de rango (más antiguo primero)
+	languages   Languages
+	templates   map[string]NotificationTemplate // guild_id -> plantilla de notificación
+	notify      map[string]NotifyPrefs          // discord_id -> preferencias de notificación
+	routes      map[string][]RouteRule          // guild_id -> reglas de enrutado (en orden de evaluación)
+	quietHours  map[string]QuietHours           // guild_id -> horas de silencio
+	digests     map[string][]QueuedMatch        // guild_id -> partidas encoladas durante las horas de silencio
+	settings    map[string]map[string]string    // guild_id -> variable de .env -> valor (/dota config set)
+	usersFile   string
+	matchesFile string
+	ranksFile   string
+	langsFile   string
+	tplsFile    string
+	notifyFile  string
+	routesFile  string
+	quietFile   string
+	digestFile  string
+	sessionFile string
+	configFile  string
 }
 
+// Languages son las preferencias de idioma: por defecto de cada servidor y elección explícita de cada usuario
+type Languages struct {
+	Guilds map[string]string `json:"guilds"` // guild_id -> código de idioma (es, en)
+	Users  map[string]string `json:"users"`  // discord_id -> código de idioma
+}
+
+// NotificationTemplate es la plantilla de notificación de partida de un servidor. Si Preset no está vacío
+// se usa la plantilla incorporada con ese nombre (compact, full, meme) y el resto se ignora; si no, los campos
+// de texto son plantillas text/template sobre la vista de la partida (ver discord/template_render.go).
+type NotificationTemplate struct {
+	Preset      string          `json:"preset,omitempty"`
+	Title       string          `json:"title,omitempty"`
+	Description string          `json:"description,omitempty"`
+	Color       string          `json:"color,omitempty"` // #rrggbb; vacío = verde/rojo según el resultado
+	Fields      []TemplateField `json:"fields,omitempty"`
+	Footer      string          `json:"footer,omitempty"`
+	Image       string          `json:"image,omitempty"`       // hero = imagen grande del héroe; vacío = ninguna
+	Thumbnail   string          `json:"thumbnail,omitempty"`   // avatar (con autor) o hero (icono); vacío = ninguna
+	Attachments bool            `json:"attachments,omitempty"` // adjuntar gráfica de ventaja y scoreboard
+}
+
+// TemplateField es un campo del embed; se omite si el nombre o el valor quedan vacíos
+type TemplateField struct {
+	Name   string `json:"name"`
+	Value  string `json:"value"`
+	Inline bool   `json:"inline,omitempty"`
+}
+
+// NotifyPrefs son las preferencias de notificación de partidas de un usuario (/dota notify).
+// El valor cero es el comportamiento por defecto: notificar todas las partidas, sin mención ni copia por MD.
+type NotifyPrefs struct {
+	Disabled       bool   `json:"disabled,omitempty"`
+	Result         string `json:"result,omitempty"` // win o loss; vacío = todas
+	RankedOnly     bool   `json:"ranked_only,omitempty"`
+	HighlightsOnly bool   `json:"highlights_only,omitempty"`
+	Mention        bool   `json:"mention,omitempty"`
+	DM             bool   `json:"dm,omitempty"` // copia privada por mensaje directo
+}
+
+// RouteRule es una regla de enrutado de notificaciones de un servidor: si la partida cumple todas las
+// condiciones no vacías, la notificación va a ChannelID en lugar del canal de notificaciones.
+type RouteRule struct {
+	GameModes  []int  `json:"game_modes,omitempty"`  // IDs de game_mode; vacío = cualquiera
+	LobbyTypes []int  `json:"lobby_types,omitempty"` // IDs de lobby_type; vacío = cualquiera
+	Result     string `json:"result,omitempty"`      // win o loss; vacío = cualquiera
+	Player     string `json:"player,omitempty"`      // discord_id del jugador; vacío = cualquiera
+	Highlight  string `json:"highlight,omitempty"`   // etiqueta de partida destacada; any = cualquier destacada
+	ChannelID  string `json:"channel_id"`
+}
+
+// QuietHours son las horas de silencio de un servidor: las partidas detectadas entre Start y End (hora local
+// de Timezone) se encolan y se publican en un único resumen al terminar la ventana.
+type QuietHours struct {
+	Start    string `json:"start"`    // HH:MM
+	End      string `json:"end"`      // HH:MM; si es anterior a Start, la ventana cruza la medianoche
+	Timezone string `json:"timezone"` // zona IANA (ej. Europe/Madrid)
+}
+
+// QueuedMatch es una partida encolada durante las horas de silencio para el resumen
+type QueuedMatch struct {
+	DiscordID  string `json:"discord_id"`
+	AccountID  string `json:"account_id"`
+	PlayerName string `json:"player_name"`
+	MatchID    int64  `json:"match_id"`
+	HeroID     int    `json:"hero_id"`
+	Won        bool   `json:"won"`
+	Kills      int    `json:"kills"`
+	Deaths     int    `json:"deaths"`
+	Assists    int    `json:"assists"`
+	Duration   int    `json:"duration"` // segundos
+}
+
+// RankSnapshot es el rango de un jugador en un momento dado
+type RankSnapshot struct {
+	RankBracket string `json:"rank_bracket"`
+	Rank        int    `json:"rank"` // seasonRank de Stratz (ej. 54 = Legend 4)
+	Time        int64  `json:"time"` // unix
+}
+
+// rankHistoryMax es el máximo de cambios de rango guardados por jugador
+const rankHistoryMax = 200
+
 func NewUserStore() (*UserStore, error) {
 	store := &UserStore{
 		users:       make(map[string]string),
 		lastMatches: make(map[string]int64),
+		sessions:    make(map[string]int64),
+		rankHistory: make(map[string][]RankSnapshot),
+		languages:   Languages{Guilds: make(map[string]string), Users: make(map[string]string)},
+		templates:   make(map[string]NotificationTemplate),
+		notify:      make(map[string]NotifyPrefs),
+		routes:      make(map[string][]RouteRule),
+		quietHours:  make(map[string]QuietHours),
+		digests:     make(map[string][]QueuedMatch),
+		settings:    make(map[string]map[string]string),
 		usersFile:   "data/users.json",
 		matchesFile: "data/last_matches.json",
+		ranksFile:   "data/rank_history.json",
+		langsFile:   "data/languages.json",
+		tplsFile:    "data/templates.json",
+		notifyFile:  "data/notify.json",
+		routesFile:  "data/routes.json",
+		quietFile:   "data/quiet_hours.json",
+		digestFile:  "data/digest_queue.json",
+		sessionFile: "data/sessions.json",
+		configFile:  "data/settings.json",
 	}
 
 	// Crear directorio data/ si no existe
@@ -51,6 +169,73 @@ func (s *UserStore) load() error {
 		}
 	}
 
+	// Cargar historial de rangos
+	if data, err := os.ReadFile(s.ranksFile); err == nil {
+		if err := json.Unmarshal(data, &s.rankHistory); err != nil {
+			return fmt.Errorf("error decodificando historial de rangos: %w", err)
+		}
+	}
+
+	// Cargar idiomas
+	if data, err := os.ReadFile(s.langsFile); err == nil {
+		if err := json.Unmarshal(data, &s.languages); err != nil {
+			return fmt.Errorf("error decodificando idiomas: %w", err)
+		}
+		if s.languages.Guilds == nil {
+			s.languages.Guilds = make(map[string]string)
+		}
+		if s.languages.Users == nil {
+			s.languages.Users = make(map[string]string)
+		}
+	}
+
+	// Cargar plantillas de notificación
+	if data, err := os.ReadFile(s.tplsFile); err == nil {
+		if err := json.Unmarshal(data, &s.templates); err != nil {
+			return fmt.Errorf("error decodificando plantillas: %w", err)
+		}
+	}
+
+	// Cargar preferencias de notificación
+	if data, err := os.ReadFile(s.notifyFile); err == nil {
+		if err := json.Unmarshal(data, &s.notify); err != nil {
+			return fmt.Errorf("error decodificando preferencias de notificación: %w", err)
+		}
+	}
+
+	// Cargar reglas de enrutado
+	if data, err := os.ReadFile(s.routesFile); err == nil {
+		if err := json.Unmarshal(data, &s.routes); err != nil {
+			return fmt.Errorf("error decodificando reglas de enrutado: %w", err)
+		}
+	}
+
+	// Cargar horas de silencio y partidas encoladas
+	if data, err := os.ReadFile(s.quietFile); err == nil {
+		if err := json.Unmarshal(data, &s.quietHours); err != nil {
+			return fmt.Errorf("error decodificando horas de silencio: %w", err)
+		}
+	}
+	if data, err := os.ReadFile(s.digestFile); err == nil {
+		if err := json.Unmarshal(data, &s.digests); err != nil {
+			return fmt.Errorf("error decodificando partidas encoladas: %w", err)
+		}
+	}
+
+	// Cargar sesiones resumidas
+	if data, err := os.ReadFile(s.sessionFile); err == nil {
+		if err := json.Unmarshal(data, &s.sessions); err != nil {
+			return fmt.Errorf("error decodificando sesiones: %w", err)
+		}
+	}
+
+	// Cargar configuración cambiada con /dota config
+	if data, err := os.ReadFile(s.configFile); err == nil {
+		if err := json.Unmarshal(data, &s.settings); err != nil {
+			return fmt.Errorf("error decodificando configuración: %w", err)
+		}
+	}
+
 	return nil
 }
 
@@ -141,3 +326,311 @@ func (s *UserStore) GetChannel() (string, error) {
 	return channelData["channel_id"], nil
 }
 
+// AddRankSnapshot guarda el rango actual de accountID si cambió respecto al último guardado.
+// Devuelve el último rango anterior (hadPrevious=false si es el primero) y si se guardó uno nuevo.
+func (s *UserStore) AddRankSnapshot(accountID string, snapshot RankSnapshot) (previous RankSnapshot, hadPrevious, changed bool, err error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	history := s.rankHistory[accountID]
+	if len(history) > 0 {
+		previous = history[len(history)-1]
+		hadPrevious = true
+		if previous.RankBracket == snapshot.RankBracket && previous.Rank == snapshot.Rank {
+			return previous, true, false, nil
+		}
+	}
+	history = append(history, snapshot)
+	if len(history) > rankHistoryMax {
+		history = history[len(history)-rankHistoryMax:]
+	}
+	s.rankHistory[accountID] = history
+
+	data, errMarshal := json.MarshalIndent(s.rankHistory, "", "  ")
+	if errMarshal != nil {
+		return previous, hadPrevious, true, fmt.Errorf("error codificando historial de rangos: %w", errMarshal)
+	}
+	if errWrite := os.WriteFile(s.ranksFile, data, 0644); errWrite != nil {
+		return previous, hadPrevious, true, fmt.Errorf("error guardando historial de rangos: %w", errWrite)
+	}
+	return previous, hadPrevious, true, nil
+}
+
+// GetRankHistory devuelve los cambios de rango de accountID, del más antiguo al más reciente
+func (s *UserStore) GetRankHistory(accountID string) []RankSnapshot {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]RankSnapshot(nil), s.rankHistory[accountID]...)
+}
+
+// SetGuildLanguage guarda el idioma por defecto del servidor
+func (s *UserStore) SetGuildLanguage(guildID, lang string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.languages.Guilds[guildID] = lang
+	return s.saveLanguages()
+}
+
+// GetGuildLanguage devuelve el idioma por defecto del servidor ("" si no tiene)
+func (s *UserStore) GetGuildLanguage(guildID string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.languages.Guilds[guildID]
+}
+
+// SetUserLanguage guarda el idioma elegido por el usuario; lang vacío borra la preferencia
+func (s *UserStore) SetUserLanguage(discordID, lang string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if lang == "" {
+		delete(s.languages.Users, discordID)
+	} else {
+		s.languages.Users[discordID] = lang
+	}
+	return s.saveLanguages()
+}
+
+// GetUserLanguage devuelve el idioma elegido por el usuario ("" si usa el de su cliente)
+func (s *UserStore) GetUserLanguage(discordID string) string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.languages.Users[discordID]
+}
+
+func (s *UserStore) saveLanguages() error {
+	data, err := json.MarshalIndent(s.languages, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error codificando idiomas: %w", err)
+	}
+	if err := os.WriteFile(s.langsFile, data, 0644); err != nil {
+		return fmt.Errorf("error guardando idiomas: %w", err)
+	}
+	return nil
+}
+
+// SetGuildTemplate guarda la plantilla de notificación del servidor; nil vuelve al formato por defecto
+func (s *UserStore) SetGuildTemplate(guildID string, tpl *NotificationTemplate) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if tpl == nil {
+		delete(s.templates, guildID)
+	} else {
+		s.templates[guildID] = *tpl
+	}
+	data, err := json.MarshalIndent(s.templates, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error codificando plantillas: %w", err)
+	}
+	if err := os.WriteFile(s.tplsFile, data, 0644); err != nil {
+		return fmt.Errorf("error guardando plantillas: %w", err)
+	}
+	return nil
+}
+
+// GetGuildTemplate devuelve la plantilla de notificación del servidor (false si usa el formato por defecto)
+func (s *UserStore) GetGuildTemplate(guildID string) (NotificationTemplate, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	tpl, ok := s.templates[guildID]
+	return tpl, ok
+}
+
+// SetNotifyPrefs guarda las preferencias de notificación del usuario; el valor cero las borra
+func (s *UserStore) SetNotifyPrefs(discordID string, prefs NotifyPrefs) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if prefs == (NotifyPrefs{}) {
+		delete(s.notify, discordID)
+	} else {
+		s.notify[discordID] = prefs
+	}
+	data, err := json.MarshalIndent(s.notify, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error codificando preferencias de notificación: %w", err)
+	}
+	if err := os.WriteFile(s.notifyFile, data, 0644); err != nil {
+		return fmt.Errorf("error guardando preferencias de notificación: %w", err)
+	}
+	return nil
+}
+
+// GetNotifyPrefs devuelve las preferencias de notificación del usuario (valor cero si no tiene)
+func (s *UserStore) GetNotifyPrefs(discordID string) NotifyPrefs {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return s.notify[discordID]
+}
+
+// AddRoute añade una regla de enrutado al final de las del servidor
+func (s *UserStore) AddRoute(guildID string, rule RouteRule) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.routes[guildID] = append(s.routes[guildID], rule)
+	return s.saveRoutes()
+}
+
+// RemoveRoute borra la regla de enrutado en la posición index (desde 0); false si no existe
+func (s *UserStore) RemoveRoute(guildID string, index int) (RouteRule, bool, error) {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	rules := s.routes[guildID]
+	if index < 0 || index >= len(rules) {
+		return RouteRule{}, false, nil
+	}
+	removed := rules[index]
+	rules = append(rules[:index:index], rules[index+1:]...)
+	if len(rules) == 0 {
+		delete(s.routes, guildID)
+	} else {
+		s.routes[guildID] = rules
+	}
+	return removed, true, s.saveRoutes()
+}
+
+// GetRoutes devuelve las reglas de enrutado del servidor en orden de evaluación
+func (s *UserStore) GetRoutes(guildID string) []RouteRule {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]RouteRule(nil), s.routes[guildID]...)
+}
+
+func (s *UserStore) saveRoutes() error {
+	data, err := json.MarshalIndent(s.routes, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error codificando reglas de enrutado: %w", err)
+	}
+	if err := os.WriteFile(s.routesFile, data, 0644); err != nil {
+		return fmt.Errorf("error guardando reglas de enrutado: %w", err)
+	}
+	return nil
+}
+
+// SetQuietHours guarda las horas de silencio del servidor; nil las desactiva (las partidas encoladas se conservan)
+func (s *UserStore) SetQuietHours(guildID string, quiet *QuietHours) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if quiet == nil {
+		delete(s.quietHours, guildID)
+	} else {
+		s.quietHours[guildID] = *quiet
+	}
+	data, err := json.MarshalIndent(s.quietHours, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error codificando horas de silencio: %w", err)
+	}
+	if err := os.WriteFile(s.quietFile, data, 0644); err != nil {
+		return fmt.Errorf("error guardando horas de silencio: %w", err)
+	}
+	return nil
+}
+
+// GetQuietHours devuelve las horas de silencio del servidor (false si no tiene)
+func (s *UserStore) GetQuietHours(guildID string) (QuietHours, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	quiet, ok := s.quietHours[guildID]
+	return quiet, ok
+}
+
+// EnqueueDigest añade una partida al resumen pendiente del servidor (una vez por partida y jugador)
+func (s *UserStore) EnqueueDigest(guildID string, match QueuedMatch) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	for _, queued := range s.digests[guildID] {
+		if queued.MatchID == match.MatchID && queued.AccountID == match.AccountID {
+			return nil
+		}
+	}
+	s.digests[guildID] = append(s.digests[guildID], match)
+	return s.saveDigests()
+}
+
+// GetDigest devuelve las partidas encoladas del servidor en orden de detección
+func (s *UserStore) GetDigest(guildID string) []QueuedMatch {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	return append([]QueuedMatch(nil), s.digests[guildID]...)
+}
+
+// DigestGuilds devuelve los servidores con partidas encoladas
+func (s *UserStore) DigestGuilds() []string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	guilds := make([]string, 0, len(s.digests))
+	for guildID, queued := range s.digests {
+		if len(queued) > 0 {
+			guilds = append(guilds, guildID)
+		}
+	}
+	return guilds
+}
+
+// ClearDigest vacía la cola del servidor (después de publicar el resumen)
+func (s *UserStore) ClearDigest(guildID string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	delete(s.digests, guildID)
+	return s.saveDigests()
+}
+
+func (s *UserStore) saveDigests() error {
+	data, err := json.MarshalIndent(s.digests, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error codificando partidas encoladas: %w", err)
+	}
+	if err := os.WriteFile(s.digestFile, data, 0644); err != nil {
+		return fmt.Errorf("error guardando partidas encoladas: %w", err)
+	}
+	return nil
+}
+
+// SetLastSession guarda la última partida de la última sesión resumida del usuario
+func (s *UserStore) SetLastSession(discordID string, matchID int64) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	s.sessions[discordID] = matchID
+	data, err := json.MarshalIndent(s.sessions, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error codificando sesiones: %w", err)
+	}
+	if err := os.WriteFile(s.sessionFile, data, 0644); err != nil {
+		return fmt.Errorf("error guardando sesiones: %w", err)
+	}
+	return nil
+}
+
+// GetLastSession devuelve la última partida de la última sesión resumida del usuario
+func (s *UserStore) GetLastSession(discordID string) (int64, bool) {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	matchID, ok := s.sessions[discordID]
+	return matchID, ok
+}
+
+// SetGuildSetting guarda el valor de la variable key (de .env) cambiada con /dota config en el servidor
+func (s *UserStore) SetGuildSetting(guildID, key, value string) error {
+	s.mu.Lock()
+	defer s.mu.Unlock()
+	if s.settings[guildID] == nil {
+		s.settings[guildID] = make(map[string]string)
+	}
+	s.settings[guildID][key] = value
+	data, err := json.MarshalIndent(s.settings, "", "  ")
+	if err != nil {
+		return fmt.Errorf("error codificando configuración: %w", err)
+	}
+	if err := os.WriteFile(s.configFile, data, 0644); err != nil {
+		return fmt.Errorf("error guardando configuración: %w", err)
+	}
+	return nil
+}
+
+// GetGuildSettings devuelve una copia de las variables cambiadas con /dota config en el servidor (variable -> valor)
+func (s *UserStore) GetGuildSettings(guildID string) map[string]string {
+	s.mu.RLock()
+	defer s.mu.RUnlock()
+	settings := make(map[string]string, len(s.settings[guildID]))
+	for key, value := range s.settings[guildID] {
+		settings[key] = value
+	}
+	return settings
+}